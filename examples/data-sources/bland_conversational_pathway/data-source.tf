@@ -1,3 +1,14 @@
 data "bland_conversational_pathway" "example" {
   id = "123"
 }
+
+data "bland_conversational_pathway" "production" {
+  id          = "123"
+  environment = "production"
+}
+
+# Commit the diagram next to the configuration to review structural changes.
+resource "local_file" "pathway_diagram" {
+  filename = "${path.module}/pathway.mmd"
+  content  = data.bland_conversational_pathway.example.graph_mermaid
+}
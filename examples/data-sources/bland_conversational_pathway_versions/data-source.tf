@@ -0,0 +1,7 @@
+data "bland_conversational_pathway_versions" "example" {
+  pathway_id = "123"
+}
+
+output "production_version" {
+  value = one([for v in data.bland_conversational_pathway_versions.example.versions : v.version_number if v.is_production])
+}
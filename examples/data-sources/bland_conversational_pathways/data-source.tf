@@ -0,0 +1,29 @@
+data "bland_conversational_pathways" "all" {}
+
+# Reading version numbers takes one request per listed pathway, skip them when
+# only the ids are needed.
+data "bland_conversational_pathways" "support" {
+  name_regex    = "^Support"
+  folder_name   = "Support"
+  updated_after = "2025-01-01T00:00:00Z"
+
+  include_versions = false
+}
+
+output "pathway_inventory" {
+  value = {
+    for p in data.bland_conversational_pathways.all.pathways : p.id => {
+      name       = p.name
+      folder     = p.folder_name
+      production = p.production_version_number
+    }
+  }
+}
+
+resource "bland_conversational_pathway_deployment" "support_staging" {
+  for_each = toset(data.bland_conversational_pathways.support.ids)
+
+  pathway_id  = each.value
+  version     = "latest"
+  environment = "staging"
+}
@@ -0,0 +1,8 @@
+data "bland_secret" "by_id" {
+  id = "secret123"
+}
+
+# The name must identify a single secret of the account.
+data "bland_secret" "crm_token" {
+  name = "crm_token"
+}
@@ -0,0 +1,5 @@
+data "bland_secrets" "all" {}
+
+output "refreshing_secret_names" {
+  value = [for s in data.bland_secrets.all.secrets : s.name if !s.static]
+}
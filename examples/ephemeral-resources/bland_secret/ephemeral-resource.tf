@@ -0,0 +1,13 @@
+# Requires Terraform 1.10 or later. The secret is read at apply time and is
+# never stored in the plan or state.
+ephemeral "bland_secret" "webhook_token" {
+  name = "webhook_token"
+}
+
+# Copy the value into another secret without persisting it in state.
+resource "bland_secret" "webhook_token_copy" {
+  name             = "webhook_token_copy"
+  static           = true
+  value_wo         = ephemeral.bland_secret.webhook_token.value
+  value_wo_version = 1
+}
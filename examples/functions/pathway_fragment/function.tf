@@ -0,0 +1,92 @@
+locals {
+  # A reusable identity verification sub-flow. "verified" and "failed" are exits bound by each pathway including it.
+  identity_verification = {
+    nodes = [
+      {
+        id   = "ask"
+        type = "Default"
+        data = {
+          name = "Ask Date Of Birth"
+          text = "Before we continue, can you confirm your date of birth?"
+          extract_vars = [
+            {
+              name        = "date_of_birth"
+              type        = "string"
+              description = "The date of birth of the caller"
+            }
+          ]
+        }
+      }
+    ]
+    edges = [
+      {
+        id     = "confirmed"
+        source = "ask"
+        target = "verified"
+        type   = "custom"
+        data = {
+          label = "date of birth provided"
+        }
+      },
+      {
+        id     = "refused"
+        source = "ask"
+        target = "failed"
+        type   = "custom"
+        data = {
+          label = "caller refuses"
+        }
+      }
+    ]
+  }
+
+  verification = provider::bland::pathway_fragment(local.identity_verification, "verify-", "ask", {
+    verified = "2"
+    failed   = "3"
+  })
+}
+
+resource "bland_conversational_pathway" "support" {
+  name        = "Support"
+  description = "Verifies the caller before helping them"
+
+  nodes = concat([
+    {
+      id   = "1"
+      type = "Default"
+      data = {
+        name     = "Start"
+        text     = "Hi, thanks for calling!"
+        is_start = true
+      }
+    },
+    {
+      id   = "2"
+      type = "Default"
+      data = {
+        name   = "Help"
+        prompt = "Ask the caller how you can help them"
+      }
+    },
+    {
+      id   = "3"
+      type = "End Call"
+      data = {
+        name   = "Goodbye"
+        prompt = "Politely end the call"
+      }
+    }
+  ], local.verification.nodes)
+
+  edges = concat([
+    {
+      id     = "to-verification"
+      source = "1"
+      target = local.verification.entry_node_id
+      type   = "custom"
+      data = {
+        label = "greeted"
+      }
+    }
+  ], local.verification.edges)
+}
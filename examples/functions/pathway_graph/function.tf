@@ -0,0 +1,11 @@
+data "bland_conversational_pathway" "example" {
+  id = "123"
+}
+
+output "pathway_mermaid" {
+  value = provider::bland::pathway_graph(data.bland_conversational_pathway.example, "mermaid")
+}
+
+output "pathway_dot" {
+  value = provider::bland::pathway_graph(data.bland_conversational_pathway.example, "dot")
+}
@@ -1,4 +1,112 @@
 resource "bland_conversational_pathway" "example" {
   name        = "Basic Pathway"
   description = "Basic pathway example"
+  folder_id   = "6c4a1d2e-8f3b-4b7a-9e21-0d5f3c7a9b12"
+
+  post_call_actions = ["send_summary_email"]
+}
+
+resource "bland_conversational_pathway" "clone" {
+  name                  = "Cloned Pathway"
+  description           = "Pathway cloned from version 3 of the basic pathway"
+  source_pathway_id     = bland_conversational_pathway.example.id
+  source_version_number = 3
+
+  global_config = {
+    global_prompt  = "Keep answers short."
+    voice          = "maya"
+    first_sentence = "Hi, this is Maya from Bland."
+
+    model_options = {
+      model_type          = "smart"
+      temperature         = 0.2
+      block_interruptions = false
+    }
+  }
+}
+
+resource "bland_conversational_pathway" "order_status" {
+  name        = "Order Status"
+  description = "Looks up an order through a custom tool"
+
+  # Passed in request_data when the call starts.
+  input_variables = ["customer_name"]
+
+  nodes = [
+    {
+      id   = "1"
+      type = "Default"
+      data = {
+        name     = "Start"
+        text     = "Hi {{customer_name}}! What is your order number?"
+        is_start = true
+        extract_vars = [
+          {
+            name        = "order_id"
+            type        = "string"
+            description = "The order number of the customer"
+          }
+        ]
+      }
+    },
+    {
+      id   = "2"
+      type = "Custom Tool"
+      data = {
+        name    = "Lookup Order"
+        tool_id = "TL-1234"
+        tool_inputs = {
+          order_id = "{{order_id}}"
+        }
+        speech = "One moment while I look that up."
+        response_data = [
+          {
+            name = "order_status"
+            data = "$.status"
+          }
+        ]
+      }
+    }
+  ]
+
+  edges = [
+    {
+      id     = "e1"
+      source = "1"
+      target = "2"
+      type   = "custom"
+      data = {
+        label = "order number provided"
+      }
+    }
+  ]
+}
+
+resource "bland_conversational_pathway" "crm_sync" {
+  name        = "CRM Sync"
+  description = "Sends the caller to the CRM without exposing its credentials"
+
+  nodes = [
+    {
+      id   = "1"
+      type = "Webhook"
+      data = {
+        name     = "Sync Caller"
+        is_start = true
+        url      = "https://crm.example.com/api/callers"
+        method   = "POST"
+        body     = "{\"phone\": \"{{from}}\"}"
+        auth = {
+          type         = "Bearer"
+          token_secret = bland_secret.crm_token.id
+        }
+        headers = [
+          {
+            name   = "X-Api-Key"
+            secret = "crm_api_key"
+          }
+        ]
+      }
+    }
+  ]
 }
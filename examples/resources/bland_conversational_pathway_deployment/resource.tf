@@ -0,0 +1,16 @@
+resource "bland_conversational_pathway" "example" {
+  name        = "Basic Pathway"
+  description = "Basic pathway example"
+}
+
+resource "bland_conversational_pathway_deployment" "staging" {
+  pathway_id  = bland_conversational_pathway.example.id
+  version     = "latest"
+  environment = "staging"
+}
+
+resource "bland_conversational_pathway_deployment" "production" {
+  pathway_id  = bland_conversational_pathway.example.id
+  version     = "3"
+  environment = "production"
+}
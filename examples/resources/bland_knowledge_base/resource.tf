@@ -0,0 +1,30 @@
+# Editing faq.md uploads it again on the next apply.
+resource "bland_knowledge_base" "faq" {
+  name        = "FAQ"
+  description = "Answers to frequently asked questions"
+  file_path   = "${path.module}/faq.md"
+
+  # Applying waits until Bland finished ingesting the file.
+  timeouts = {
+    create = "30m"
+    update = "30m"
+  }
+}
+
+resource "bland_knowledge_base" "opening_hours" {
+  name        = "Opening hours"
+  description = "Opening hours of the stores"
+  text        = "All stores are open from 9am to 5pm, Monday to Saturday."
+}
+
+# Changing web_source scrapes the pages again on the next apply.
+resource "bland_knowledge_base" "help_center" {
+  name        = "Help center"
+  description = "Public help center pages"
+
+  web_source = {
+    sitemap_url = "https://help.example.com/sitemap.xml"
+    max_depth   = 1
+    max_pages   = 200
+  }
+}
@@ -0,0 +1 @@
+terraform import bland_pathway_folder.support "folder-id-123"
\ No newline at end of file
@@ -0,0 +1,14 @@
+resource "bland_pathway_folder" "support" {
+  name = "Support"
+}
+
+resource "bland_pathway_folder" "billing" {
+  name             = "Billing"
+  parent_folder_id = bland_pathway_folder.support.id
+}
+
+resource "bland_conversational_pathway" "refunds" {
+  name        = "Refunds"
+  description = "Handles refund requests"
+  folder_id   = bland_pathway_folder.billing.id
+}
@@ -0,0 +1,47 @@
+resource "bland_pathway_test" "order_status" {
+  pathway_id = bland_conversational_pathway.order_status.id
+
+  request_data = {
+    customer_name = "Jane"
+  }
+
+  # Run the conversation again whenever the pathway changes.
+  triggers = {
+    revision = bland_conversational_pathway.order_status.revision_number
+  }
+
+  turns = [
+    {
+      message                  = "Hello"
+      expect_node              = "Start"
+      expect_response_contains = ["order number"]
+    },
+    {
+      message     = "It is 4521"
+      expect_node = "Lookup Order"
+      expect_variables = {
+        order_id = "4521"
+      }
+    }
+  ]
+}
+
+# Report failed assertions as warnings and check them instead of failing the apply.
+resource "bland_pathway_test" "smoke" {
+  pathway_id        = bland_conversational_pathway.order_status.id
+  fail_on_assertion = false
+
+  turns = [
+    {
+      message     = "Hi"
+      expect_node = "Start"
+    }
+  ]
+}
+
+check "pathway_smoke_test" {
+  assert {
+    condition     = length(bland_pathway_test.smoke.failures) == 0
+    error_message = join("\n", bland_pathway_test.smoke.failures)
+  }
+}
@@ -0,0 +1,36 @@
+resource "bland_secret" "api_key" {
+  name   = "crm_api_key"
+  static = true
+  value  = var.crm_api_key
+}
+
+resource "bland_secret" "crm_token" {
+  name   = "crm_token"
+  static = false
+
+  config = {
+    url              = "https://auth.example.com/oauth/token"
+    method           = "POST"
+    response         = "access_token"
+    body             = jsonencode({ grant_type = "client_credentials" })
+    refresh_interval = 300
+    headers = {
+      "Authorization" = "Basic ${base64encode("${var.client_id}:${var.client_secret}")}"
+      "Content-Type"  = "application/json"
+    }
+  }
+
+  # Send the config again after rotating the client secret upstream.
+  rotation_triggers = {
+    client_secret_version = var.client_secret_version
+  }
+}
+
+# Requires Terraform 1.11 or later. The value never lands in the plan or state,
+# increment value_wo_version to send a new value.
+resource "bland_secret" "webhook_token" {
+  name             = "webhook_token"
+  static           = true
+  value_wo         = var.webhook_token
+  value_wo_version = 1
+}
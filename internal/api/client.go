@@ -181,6 +181,8 @@ func prepareRequestBody(body any) (io.Reader, error) {
 	return bodyBuffer, nil
 }
 
+// ExecuteMultipart sends a streamed request body once. The body cannot be read again, so retryable status codes are
+// returned as errors like any other unacceptable status code instead of being retried.
 func (client *Client) ExecuteMultipart(ctx context.Context, method, url string, headers http.Header, body io.Reader, acceptableStatusCodes []int, responseObj any) (*Response, error) {
 	request, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
@@ -206,17 +208,5 @@ func (client *Client) ExecuteMultipart(ctx context.Context, method, url string,
 		}
 		return resp, nil
 	}
-
-	isRetryable := arrays.Contains(retryableStatusCodes, resp.HttpResponse.StatusCode)
-	if !isRetryable {
-		return resp, NewUnexpectedHttpStatusCodeError(acceptableStatusCodes, resp.HttpResponse.StatusCode, resp.HttpResponse.Status, resp.BodyAsBytes)
-	}
-
-	waitFor := retryAfter(ctx, resp.HttpResponse)
-	tflog.Debug(ctx, fmt.Sprintf("Received status code %d for request %s, retrying after %s", resp.HttpResponse.StatusCode, url, waitFor))
-	err = client.SleepWithContext(ctx, waitFor)
-	if err != nil {
-		return resp, err
-	}
-	return resp, nil
+	return resp, NewUnexpectedHttpStatusCodeError(acceptableStatusCodes, resp.HttpResponse.StatusCode, resp.HttpResponse.Status, resp.BodyAsBytes)
 }
@@ -6,7 +6,9 @@ package pathways
 import (
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jameshiester/terraform-provider-bland/internal/secret"
 )
 
 func ConvertFromPathwayNodeDataExtractVars(vals []interface{}) (ConversationalPathwayNodeDataExtractVariableModel, error) {
@@ -112,30 +114,31 @@ func ConvertFromPathwayNodeDataDto(data *pathwayNodeDataDto) (*ConversationalPat
 		FallbackNodeId: types.StringPointerValue(data.FallbackNodeId),
 		TimeoutValue:   types.Int64PointerValue(convertIntToInt64(data.TimeoutValue)),
 		MaxRetries:     types.Int64PointerValue(convertIntToInt64(data.MaxRetries)),
+		ToolID:         types.StringPointerValue(data.ToolID),
+		Speech:         types.StringPointerValue(data.Speech),
 	}
-	if data.ModelOptions != nil {
-		model.ModelOptions = &ConversationalPathwayNodeDataModelOptionModel{
-			Type:                  types.StringValue(data.ModelOptions.Type),
-			InterruptionThreshold: types.StringPointerValue(data.ModelOptions.InterruptionThreshold),
-			Temperature:           types.Float32PointerValue(data.ModelOptions.Temperature),
-			SkipUserResponse:      types.BoolPointerValue(data.ModelOptions.SkipUserResponse),
-			BlockInterruptions:    types.BoolPointerValue(data.ModelOptions.BlockInterruptions),
+	if data.ToolInputs != nil {
+		model.ToolInputs = make(map[string]types.String, len(*data.ToolInputs))
+		for name, value := range *data.ToolInputs {
+			model.ToolInputs[name] = types.StringValue(value)
 		}
 	}
+	model.ModelOptions = ConvertFromModelOptionDto(data.ModelOptions)
 	if data.Auth != nil {
 		model.Auth = &ConversationalPathwayAuthModel{
 			Type:   types.StringValue(data.Auth.Type),
-			Token:  types.StringValue(data.Auth.Token),
 			Encode: types.BoolValue(data.Auth.Encode),
 		}
+		model.Auth.Token, model.Auth.TokenSecret = convertFromSecretValue(data.Auth.Token)
 	}
 	if data.Headers != nil {
 		for _, h := range *data.Headers {
 			if len(h) == 2 {
-				model.Headers = append(model.Headers, ConversationalPathwayHeaderModel{
-					Name:  types.StringValue(h[0]),
-					Value: types.StringValue(h[1]),
-				})
+				header := ConversationalPathwayHeaderModel{
+					Name: types.StringValue(h[0]),
+				}
+				header.Value, header.Secret = convertFromSecretValue(h[1])
+				model.Headers = append(model.Headers, header)
 			}
 		}
 	}
@@ -269,18 +272,7 @@ func ConvertFromPathwayNodeDataModel(data ConversationalPathwayNodeDataModel) *p
 		responsePathways = nil
 	}
 
-	var modelOptions *modelOptionDto
-	if data.ModelOptions != nil {
-		modelOptions = &modelOptionDto{
-			Type:                  data.ModelOptions.Type.ValueString(),
-			InterruptionThreshold: data.ModelOptions.InterruptionThreshold.ValueStringPointer(),
-			Temperature:           data.ModelOptions.Temperature.ValueFloat32Pointer(),
-			SkipUserResponse:      data.ModelOptions.SkipUserResponse.ValueBoolPointer(),
-			BlockInterruptions:    data.ModelOptions.BlockInterruptions.ValueBoolPointer(),
-		}
-	} else {
-		modelOptions = nil
-	}
+	modelOptions := ConvertFromModelOptionModel(data.ModelOptions)
 
 	var pathwayExamples *[]pathwayExampleDto
 	if len(data.PathwayExamples) > 0 {
@@ -297,7 +289,7 @@ func ConvertFromPathwayNodeDataModel(data ConversationalPathwayNodeDataModel) *p
 	if len(data.Headers) > 0 {
 		tmp := make([][]string, 0, len(data.Headers))
 		for _, h := range data.Headers {
-			tmp = append(tmp, []string{h.Name.ValueString(), h.Value.ValueString()})
+			tmp = append(tmp, []string{h.Name.ValueString(), convertToSecretValue(h.Value, h.Secret)})
 		}
 		headers = &tmp
 	} else {
@@ -308,7 +300,7 @@ func ConvertFromPathwayNodeDataModel(data ConversationalPathwayNodeDataModel) *p
 	if data.Auth != nil {
 		auth = &AuthDto{
 			Type:   data.Auth.Type.ValueString(),
-			Token:  data.Auth.Token.ValueString(),
+			Token:  convertToSecretValue(data.Auth.Token, data.Auth.TokenSecret),
 			Encode: data.Auth.Encode.ValueBool(),
 		}
 	} else {
@@ -357,6 +349,14 @@ func ConvertFromPathwayNodeDataModel(data ConversationalPathwayNodeDataModel) *p
 		m := int(data.MaxRetries.ValueInt64())
 		maxRetries = &m
 	}
+	var toolInputs *map[string]string
+	if data.ToolInputs != nil {
+		inputs := make(map[string]string, len(data.ToolInputs))
+		for name, value := range data.ToolInputs {
+			inputs[name] = value.ValueString()
+		}
+		toolInputs = &inputs
+	}
 
 	return &pathwayNodeDataDto{
 		Name:             data.Name.ValueString(),
@@ -384,6 +384,9 @@ func ConvertFromPathwayNodeDataModel(data ConversationalPathwayNodeDataModel) *p
 		FallbackNodeId:   fallbackNodeId,
 		TimeoutValue:     timeoutValue,
 		MaxRetries:       maxRetries,
+		ToolID:           data.ToolID.ValueStringPointer(),
+		ToolInputs:       toolInputs,
+		Speech:           data.Speech.ValueStringPointer(),
 	}
 }
 
@@ -424,12 +427,37 @@ func ConvertFromPathwayNodeDto(node pathwayNodeDto) (*ConversationalPathwayNodeM
 	}, nil
 }
 
-func ConvertFromPathwayGlobalConfigNodeDto(node pathwayNodeDto) *ConversationalPathwayGlobalConfig {
-	return &ConversationalPathwayGlobalConfig{
-		GlobalPrompt: types.StringValue(node.GlobalConfig.GlobalPrompt),
+func ConvertFromModelOptionDto(options *modelOptionDto) *ConversationalPathwayNodeDataModelOptionModel {
+	if options == nil {
+		return nil
+	}
+	return &ConversationalPathwayNodeDataModelOptionModel{
+		Type:                  types.StringValue(options.Type),
+		InterruptionThreshold: types.StringPointerValue(options.InterruptionThreshold),
+		Temperature:           types.Float32PointerValue(options.Temperature),
+		SkipUserResponse:      types.BoolPointerValue(options.SkipUserResponse),
+		BlockInterruptions:    types.BoolPointerValue(options.BlockInterruptions),
 	}
 }
 
+func ConvertFromModelOptionModel(options *ConversationalPathwayNodeDataModelOptionModel) *modelOptionDto {
+	if options == nil {
+		return nil
+	}
+	return &modelOptionDto{
+		Type:                  options.Type.ValueString(),
+		InterruptionThreshold: options.InterruptionThreshold.ValueStringPointer(),
+		Temperature:           options.Temperature.ValueFloat32Pointer(),
+		SkipUserResponse:      options.SkipUserResponse.ValueBoolPointer(),
+		BlockInterruptions:    options.BlockInterruptions.ValueBoolPointer(),
+	}
+}
+
+func ConvertFromPathwayGlobalConfigNodeDto(node pathwayNodeDto) *ConversationalPathwayGlobalConfig {
+	config := ConvertFromPathwayGlobalConfigDto(node.GlobalConfig)
+	return &config
+}
+
 func ConvertFromPathwayNodeModel(node ConversationalPathwayNodeModel) pathwayNodeDto {
 	return pathwayNodeDto{
 		ID:   node.ID.ValueStringPointer(),
@@ -503,21 +531,31 @@ func ConvertFromPathwayGlobalConfigDto(config *pathwayGlobalConfigDto) Conversat
 		return ConversationalPathwayGlobalConfig{}
 	}
 	return ConversationalPathwayGlobalConfig{
-		GlobalPrompt: types.StringValue(config.GlobalPrompt),
+		GlobalPrompt:  types.StringPointerValue(config.GlobalPrompt),
+		ModelOptions:  ConvertFromModelOptionDto(config.ModelOptions),
+		Voice:         types.StringPointerValue(config.Voice),
+		FirstSentence: types.StringPointerValue(config.FirstSentence),
+		KnowledgeBase: types.StringPointerValue(config.KnowledgeBase),
+		KbTool:        types.StringPointerValue(config.KbTool),
 	}
 }
 
 func ConvertFromPathwayGlobalConfigModel(config ConversationalPathwayGlobalConfig) pathwayNodeDto {
 	return pathwayNodeDto{
 		GlobalConfig: &pathwayGlobalConfigDto{
-			GlobalPrompt: config.GlobalPrompt.ValueString(),
+			GlobalPrompt:  config.GlobalPrompt.ValueStringPointer(),
+			ModelOptions:  ConvertFromModelOptionModel(config.ModelOptions),
+			Voice:         config.Voice.ValueStringPointer(),
+			FirstSentence: config.FirstSentence.ValueStringPointer(),
+			KnowledgeBase: config.KnowledgeBase.ValueStringPointer(),
+			KbTool:        config.KbTool.ValueStringPointer(),
 		},
 	}
 }
 
-func ConvertFromPathwayDto(pathway pathwayDto) (*ConversationalPathwayDataSourceModel, error) {
+func ConvertFromPathwayDto(pathway pathwayDto) (*ConversationalPathwayModel, error) {
 
-	path := ConversationalPathwayDataSourceModel{
+	path := ConversationalPathwayModel{
 		ID:          types.StringValue(pathway.ID),
 		Name:        types.StringValue(pathway.Name),
 		Description: types.StringValue(pathway.Description),
@@ -537,11 +575,13 @@ func ConvertFromPathwayDto(pathway pathwayDto) (*ConversationalPathwayDataSource
 		edgeModel := ConvertFromPathwayEdgeDto(edge)
 		path.Edges = append(path.Edges, edgeModel)
 	}
+	path.PostCallActions = convertStringsToList(pathway.PostCallActions)
+	path.FolderID = types.StringPointerValue(pathway.FolderID)
 
 	return &path, nil
 }
 
-func ConvertFromPathwayModel(pathway ConversationalPathwayDataSourceModel) pathwayDto {
+func ConvertFromPathwayModel(pathway ConversationalPathwayModel) pathwayDto {
 
 	path := pathwayDto{
 		ID:          pathway.ID.ValueString(),
@@ -560,9 +600,89 @@ func ConvertFromPathwayModel(pathway ConversationalPathwayDataSourceModel) pathw
 		edgeModel := ConvertFromPathwayEdgeModel(edge)
 		path.Edges = append(path.Edges, edgeModel)
 	}
+	path.PostCallActions = convertListToStrings(pathway.PostCallActions)
+	path.FolderID = pathway.FolderID.ValueStringPointer()
 	return path
 }
 
+func ConvertFromPathwayVersionDto(version pathwayVersionDto) ConversationalPathwayVersionModel {
+	return ConversationalPathwayVersionModel{
+		VersionNumber:       types.Int64Value(int64(version.VersionNumber)),
+		RevisionNumber:      types.Int64Value(int64(version.RevisionNumber)),
+		Name:                types.StringValue(version.Name),
+		CreatedAt:           types.StringValue(version.CreatedAt),
+		SourceVersionNumber: types.Int64PointerValue(convertIntToInt64(version.SourceVersionNumber)),
+		IsStaging:           types.BoolValue(version.IsStaging != nil && *version.IsStaging),
+		IsProduction:        types.BoolValue(version.IsProduction != nil && *version.IsProduction),
+		IsPublished:         types.BoolValue(version.IsPrevPublished != nil && *version.IsPrevPublished),
+	}
+}
+
+// ConvertFromPathwaySummaryDto converts a listed pathway and its versions, sorted newest first, into a summary.
+func ConvertFromPathwaySummaryDto(pathway pathwaySummaryDto, versions []pathwayVersionDto) ConversationalPathwaySummaryModel {
+	summary := ConversationalPathwaySummaryModel{
+		ID:                      types.StringValue(pathway.ID),
+		Name:                    types.StringValue(pathway.Name),
+		Description:             types.StringValue(pathway.Description),
+		FolderID:                types.StringPointerValue(pathway.FolderID),
+		FolderName:              types.StringNull(),
+		CreatedAt:               types.StringValue(pathway.CreatedAt),
+		UpdatedAt:               types.StringValue(pathway.UpdatedAt),
+		LatestVersionNumber:     types.Int64Null(),
+		StagingVersionNumber:    types.Int64Null(),
+		ProductionVersionNumber: types.Int64Null(),
+	}
+	if latest, found := FindLatestVersion(versions); found {
+		summary.LatestVersionNumber = types.Int64Value(int64(latest))
+	}
+	if staging, found := FindPromotedVersion(versions, PATHWAY_ENVIRONMENT_STAGING); found {
+		summary.StagingVersionNumber = types.Int64Value(int64(staging))
+	}
+	if production, found := FindPromotedVersion(versions, PATHWAY_ENVIRONMENT_PRODUCTION); found {
+		summary.ProductionVersionNumber = types.Int64Value(int64(production))
+	}
+	return summary
+}
+
+// convertFromSecretValue splits a value read from Bland into a plain value or the name of the secret it references.
+func convertFromSecretValue(value string) (types.String, types.String) {
+	if name, ok := secret.PlaceholderName(value); ok {
+		return types.StringNull(), types.StringValue(name)
+	}
+	return types.StringValue(value), types.StringNull()
+}
+
+// convertToSecretValue returns the plain value, or the placeholder of the referenced secret when one is set.
+func convertToSecretValue(value, secretRef types.String) string {
+	if !secretRef.IsNull() && !secretRef.IsUnknown() {
+		return secret.Placeholder(secretRef.ValueString())
+	}
+	return value.ValueString()
+}
+
+// convertStringsToList converts a string slice to a list value, a nil slice becomes an empty list.
+func convertStringsToList(values []string) types.List {
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+// convertListToStrings converts a list of strings to a slice, null and unknown lists become nil.
+func convertListToStrings(list types.List) []string {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+	values := make([]string, 0, len(list.Elements()))
+	for _, element := range list.Elements() {
+		if value, ok := element.(types.String); ok {
+			values = append(values, value.ValueString())
+		}
+	}
+	return values
+}
+
 func convertIntToInt64(i *int) *int64 {
 	if i == nil {
 		return nil
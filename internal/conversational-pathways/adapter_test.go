@@ -0,0 +1,152 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestConvertPathwayGlobalConfig_RoundTrip(t *testing.T) {
+	raw := `{
+		"globalPrompt": "Be nice",
+		"modelOptions": {"modelType": "smart", "temperature": 0.2, "block_interruptions": true},
+		"voice": "maya",
+		"firstSentence": "Hi, this is Maya.",
+		"kbTool": "KB-123"
+	}`
+	var config pathwayGlobalConfigDto
+	if err := json.Unmarshal([]byte(raw), &config); err != nil {
+		t.Fatalf("failed to unmarshal global config: %v", err)
+	}
+
+	model := ConvertFromPathwayGlobalConfigNodeDto(pathwayNodeDto{GlobalConfig: &config})
+	if model.Voice.ValueString() != "maya" || model.FirstSentence.ValueString() != "Hi, this is Maya." {
+		t.Errorf("unexpected voice or first sentence: %v %v", model.Voice, model.FirstSentence)
+	}
+	if model.ModelOptions == nil || model.ModelOptions.Type.ValueString() != "smart" || !model.ModelOptions.BlockInterruptions.ValueBool() {
+		t.Errorf("unexpected model options: %+v", model.ModelOptions)
+	}
+	if !model.KnowledgeBase.IsNull() || model.KbTool.ValueString() != "KB-123" {
+		t.Errorf("unexpected knowledge base defaults: %v %v", model.KnowledgeBase, model.KbTool)
+	}
+
+	node := ConvertFromPathwayGlobalConfigModel(*model)
+	if !jsonEqual(node.GlobalConfig, config) {
+		roundTrip, _ := json.Marshal(node.GlobalConfig)
+		t.Errorf("expected global config to survive a round trip, got %s", roundTrip)
+	}
+}
+
+func TestConvertPathwayGlobalConfig_RoundTrip_NoPrompt(t *testing.T) {
+	raw := `{"voice": "maya", "kb": "KB-123"}`
+	var config pathwayGlobalConfigDto
+	if err := json.Unmarshal([]byte(raw), &config); err != nil {
+		t.Fatalf("failed to unmarshal global config: %v", err)
+	}
+
+	model := ConvertFromPathwayGlobalConfigNodeDto(pathwayNodeDto{GlobalConfig: &config})
+	if !model.GlobalPrompt.IsNull() {
+		t.Errorf("expected global prompt to be null when Bland returns none, got %v", model.GlobalPrompt)
+	}
+
+	node := ConvertFromPathwayGlobalConfigModel(*model)
+	roundTrip, _ := json.Marshal(node.GlobalConfig)
+	if !jsonEqual(node.GlobalConfig, config) || regexp.MustCompile(`globalPrompt`).Match(roundTrip) {
+		t.Errorf("expected global config without a prompt to survive a round trip, got %s", roundTrip)
+	}
+}
+
+func TestFilterPathwaySummaries(t *testing.T) {
+	pathways := []pathwaySummaryDto{
+		{ID: "3", Name: "Support Intake", FolderID: strPtr("f1"), UpdatedAt: "2025-07-23T00:16:28.052Z"},
+		{ID: "1", Name: "Sales", UpdatedAt: "2024-12-01T08:30:00Z"},
+		{ID: "2", Name: "Support Escalation", FolderID: strPtr("f1"), UpdatedAt: "not a timestamp"},
+		{ID: "4", Name: "Support Intake", FolderID: strPtr("f2"), UpdatedAt: "2025-02-01T00:00:00Z"},
+	}
+	ids := func(pathways []pathwaySummaryDto) []string {
+		result := make([]string, 0, len(pathways))
+		for _, p := range pathways {
+			result = append(result, p.ID)
+		}
+		return result
+	}
+	after, _ := time.Parse(time.RFC3339, "2025-01-01T00:00:00Z")
+
+	tests := []struct {
+		name     string
+		filter   PathwaySummaryFilter
+		expected []string
+	}{
+		{name: "no filter, sorted by name and id", filter: PathwaySummaryFilter{}, expected: []string{"1", "2", "3", "4"}},
+		{name: "name regex", filter: PathwaySummaryFilter{NameRegex: regexp.MustCompile("^Support")}, expected: []string{"2", "3", "4"}},
+		{name: "folder", filter: PathwaySummaryFilter{FolderID: strPtr("f1")}, expected: []string{"2", "3"}},
+		{name: "updated after skips unparseable timestamps", filter: PathwaySummaryFilter{UpdatedAfter: &after}, expected: []string{"3", "4"}},
+		{name: "all filters", filter: PathwaySummaryFilter{NameRegex: regexp.MustCompile("Intake"), FolderID: strPtr("f1"), UpdatedAfter: &after}, expected: []string{"3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ids(FilterPathwaySummaries(pathways, tt.filter))
+			if len(got) != len(tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("expected %v, got %v", tt.expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestConvertFromPathwaySummaryDto(t *testing.T) {
+	versions := []pathwayVersionDto{
+		{VersionNumber: 3, IsStaging: boolPtr(true)},
+		{VersionNumber: 2, IsProduction: boolPtr(true)},
+	}
+	summary := ConvertFromPathwaySummaryDto(pathwaySummaryDto{ID: "1", Name: "Sales"}, versions)
+	if summary.LatestVersionNumber.ValueInt64() != 3 || summary.StagingVersionNumber.ValueInt64() != 3 || summary.ProductionVersionNumber.ValueInt64() != 2 {
+		t.Errorf("unexpected version summary %+v", summary)
+	}
+	if !summary.FolderID.IsNull() {
+		t.Errorf("expected null folder id, got %s", summary.FolderID)
+	}
+
+	summary = ConvertFromPathwaySummaryDto(pathwaySummaryDto{ID: "1", Name: "Sales"}, nil)
+	if !summary.LatestVersionNumber.IsNull() || !summary.StagingVersionNumber.IsNull() || !summary.ProductionVersionNumber.IsNull() {
+		t.Errorf("expected null version numbers without versions, got %+v", summary)
+	}
+}
+
+func TestPathwayGraphKnown(t *testing.T) {
+	nodeType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}
+	pathwayType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":          tftypes.String,
+		"nodes":         tftypes.List{ElementType: nodeType},
+		"edges":         tftypes.List{ElementType: nodeType},
+		"global_config": nodeType,
+	}}
+	pathway := func(name, nodeID tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(pathwayType, map[string]tftypes.Value{
+			"name":          name,
+			"nodes":         tftypes.NewValue(tftypes.List{ElementType: nodeType}, []tftypes.Value{tftypes.NewValue(nodeType, map[string]tftypes.Value{"id": nodeID})}),
+			"edges":         tftypes.NewValue(tftypes.List{ElementType: nodeType}, nil),
+			"global_config": tftypes.NewValue(nodeType, nil),
+		})
+	}
+
+	if !pathwayGraphKnown(pathway(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), tftypes.NewValue(tftypes.String, "1"))) {
+		t.Error("expected unknown values outside the nodes, edges and global config to be ignored")
+	}
+	if pathwayGraphKnown(pathway(tftypes.NewValue(tftypes.String, "Pathway"), tftypes.NewValue(tftypes.String, tftypes.UnknownValue))) {
+		t.Error("expected an unknown node value to make the graph unknown")
+	}
+	if pathwayGraphKnown(tftypes.NewValue(pathwayType, tftypes.UnknownValue)) {
+		t.Error("expected an unknown pathway to make the graph unknown")
+	}
+}
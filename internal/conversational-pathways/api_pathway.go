@@ -51,11 +51,12 @@ func (client *client) CreatePathway(ctx context.Context, pathwayToCreate createP
 		return nil, fmt.Errorf("failed to create pathway: %s", "invalid data in response")
 	}
 	pathway := pathwayDto{
-		ID:          response.Data.ID,
-		Name:        pathwayToCreate.Name,
-		Description: pathwayToCreate.Description,
-		Nodes:       pathwayToCreate.Nodes,
-		Edges:       pathwayToCreate.Edges,
+		ID:              response.Data.ID,
+		Name:            pathwayToCreate.Name,
+		Description:     pathwayToCreate.Description,
+		Nodes:           pathwayToCreate.Nodes,
+		Edges:           pathwayToCreate.Edges,
+		PostCallActions: pathwayToCreate.PostCallActions,
 	}
 
 	return &pathway, nil
@@ -107,6 +108,9 @@ func (client *client) UpdatePathway(ctx context.Context, pathwayID string, pathw
 	updatedPathway.Description = pathwayToUpdate.Description
 	updatedPathway.Nodes = pathwayToUpdate.Nodes
 	updatedPathway.Edges = pathwayToUpdate.Edges
+	if pathwayToUpdate.PostCallActions != nil {
+		updatedPathway.PostCallActions = *pathwayToUpdate.PostCallActions
+	}
 	return &updatedPathway, nil
 }
 
@@ -127,11 +131,58 @@ func (client *client) GetPathway(ctx context.Context, pathwayID string) (*pathwa
 	}
 
 	result := pathwayDto{
-		ID:          pathwayID,
-		Name:        pathway.Name,
-		Description: pathway.Description,
-		Nodes:       pathway.Nodes,
-		Edges:       pathway.Edges,
+		ID:              pathwayID,
+		Name:            pathway.Name,
+		Description:     pathway.Description,
+		Nodes:           pathway.Nodes,
+		Edges:           pathway.Edges,
+		PostCallActions: pathway.PostCallActions,
+		FolderID:        pathway.FolderID,
+	}
+
+	return &result, nil
+}
+
+// ListPathways lists all pathways of the account without their nodes and edges.
+func (client *client) ListPathways(ctx context.Context) ([]pathwaySummaryDto, error) {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.Config.BaseURL,
+		Path:   "/v1/pathway",
+	}
+
+	var pathways []pathwaySummaryDto
+	_, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &pathways)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pathways: %w", err)
+	}
+	return pathways, nil
+}
+
+func (client *client) GetPathwayVersion(ctx context.Context, pathwayID string, versionNumber int) (*pathwayDto, error) {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.Config.BaseURL,
+		Path:   fmt.Sprintf("/v1/pathway/%s/version/%d", pathwayID, versionNumber),
+	}
+
+	pathway := getPathwayDto{}
+	_, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &pathway)
+	if err != nil {
+		if strings.Contains(err.Error(), "PathwayNotFound") {
+			return nil, api.WrapIntoProviderError(err, api.ErrorCode(constants.ERROR_OBJECT_NOT_FOUND), fmt.Sprintf("Version %d of pathway '%s' not found", versionNumber, pathwayID))
+		}
+		return nil, fmt.Errorf("failed to get pathway version: %w", err)
+	}
+
+	result := pathwayDto{
+		ID:              pathwayID,
+		Name:            pathway.Name,
+		Description:     pathway.Description,
+		Nodes:           pathway.Nodes,
+		Edges:           pathway.Edges,
+		PostCallActions: pathway.PostCallActions,
+		FolderID:        pathway.FolderID,
 	}
 
 	return &result, nil
@@ -150,3 +201,30 @@ func (client *client) DeletePathway(ctx context.Context, pathwayID string) error
 	}
 	return nil
 }
+
+func (client *client) PublishPathway(ctx context.Context, pathwayID string, versionNumber int, environment string) error {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.Config.BaseURL,
+		Path:   fmt.Sprintf("/v1/pathway/%s/publish", pathwayID),
+	}
+
+	publish := publishPathwayDto{
+		VersionNumber: versionNumber,
+		Environment:   environment,
+	}
+	response := publishPathwayResponseDto{}
+	_, err := client.Api.Execute(ctx, nil, "POST", apiUrl.String(), nil, publish, []int{http.StatusOK}, &response)
+	if err != nil {
+		return fmt.Errorf("failed to publish pathway: %w", err)
+	}
+
+	if response.Errors != nil && len(*response.Errors) > 0 {
+		messages := make([]string, 0, len(*response.Errors))
+		for _, err := range *response.Errors {
+			messages = append(messages, err.Message)
+		}
+		return fmt.Errorf("failed to publish pathway version %d to %s: %s", versionNumber, environment, strings.Join(messages, ". "))
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/jameshiester/terraform-provider-bland/internal/constants"
+)
+
+// CreatePathwayChat starts a chat with a pathway, used to run test conversations without placing calls.
+func (client *client) CreatePathwayChat(ctx context.Context, chat createPathwayChatDto) (string, error) {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.Config.BaseURL,
+		Path:   "/v1/pathway/chat/create",
+	}
+
+	response := createPathwayChatResponseDto{}
+	_, err := client.Api.Execute(ctx, nil, "POST", apiUrl.String(), nil, chat, []int{http.StatusOK}, &response)
+	if err != nil {
+		return "", fmt.Errorf("failed to create pathway chat: %w", err)
+	}
+	if err := chatErrors(response.Errors); err != nil {
+		return "", fmt.Errorf("failed to create pathway chat: %w", err)
+	}
+	if response.Data == nil || response.Data.ChatID == "" {
+		return "", fmt.Errorf("failed to create pathway chat: no chat id returned")
+	}
+	return response.Data.ChatID, nil
+}
+
+// SendPathwayChatMessage sends a user message to a pathway chat and returns the responses of the agent.
+func (client *client) SendPathwayChatMessage(ctx context.Context, chatID string, message string) (*pathwayChatDto, error) {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.Config.BaseURL,
+		Path:   fmt.Sprintf("/v1/pathway/chat/%s", chatID),
+	}
+
+	response := pathwayChatResponseDto{}
+	_, err := client.Api.Execute(ctx, nil, "POST", apiUrl.String(), nil, pathwayChatMessageDto{Message: message}, []int{http.StatusOK}, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send pathway chat message: %w", err)
+	}
+	if err := chatErrors(response.Errors); err != nil {
+		return nil, fmt.Errorf("failed to send pathway chat message: %w", err)
+	}
+	if response.Data == nil {
+		return nil, fmt.Errorf("failed to send pathway chat message: no response returned")
+	}
+	return response.Data, nil
+}
+
+func chatErrors(errors *[]errorDto) error {
+	if errors == nil || len(*errors) == 0 {
+		return nil
+	}
+	messages := make([]string, 0, len(*errors))
+	for _, err := range *errors {
+		messages = append(messages, err.Message)
+	}
+	return fmt.Errorf("%s", strings.Join(messages, ". "))
+}
@@ -0,0 +1,134 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/jameshiester/terraform-provider-bland/internal/api"
+	"github.com/jameshiester/terraform-provider-bland/internal/constants"
+)
+
+func (client *client) CreatePathwayFolder(ctx context.Context, folder createPathwayFolderDto) (*pathwayFolderDto, error) {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.Config.BaseURL,
+		Path:   "/v1/pathway/folders",
+	}
+
+	response := pathwayFolderResponseDto{}
+	_, err := client.Api.Execute(ctx, nil, "POST", apiUrl.String(), nil, folder, []int{http.StatusOK, http.StatusCreated}, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pathway folder: %w", err)
+	}
+	if err := folderErrors(response.Errors); err != nil {
+		return nil, fmt.Errorf("failed to create pathway folder: %w", err)
+	}
+	if response.Data == nil || response.Data.ID == "" {
+		return nil, fmt.Errorf("failed to create pathway folder: %s", "invalid data in response")
+	}
+	return response.Data, nil
+}
+
+// GetPathwayFolders lists all pathway folders of the account.
+func (client *client) GetPathwayFolders(ctx context.Context) ([]pathwayFolderDto, error) {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.Config.BaseURL,
+		Path:   "/v1/pathway/folders",
+	}
+
+	response := pathwayFoldersResponseDto{}
+	_, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pathway folders: %w", err)
+	}
+	if err := folderErrors(response.Errors); err != nil {
+		return nil, fmt.Errorf("failed to get pathway folders: %w", err)
+	}
+	return response.Data, nil
+}
+
+// GetPathwayFolder finds a folder by id. Bland has no endpoint to read a single folder, so all folders are listed.
+func (client *client) GetPathwayFolder(ctx context.Context, folderID string) (*pathwayFolderDto, error) {
+	folders, err := client.GetPathwayFolders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, folder := range folders {
+		if folder.ID == folderID {
+			return &folder, nil
+		}
+	}
+	return nil, api.WrapIntoProviderError(nil, api.ErrorCode(constants.ERROR_OBJECT_NOT_FOUND), fmt.Sprintf("Pathway folder '%s' not found", folderID))
+}
+
+func (client *client) UpdatePathwayFolder(ctx context.Context, folderID string, folder updatePathwayFolderDto) (*pathwayFolderDto, error) {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.Config.BaseURL,
+		Path:   fmt.Sprintf("/v1/pathway/folders/%s", folderID),
+	}
+
+	response := pathwayFolderResponseDto{}
+	_, err := client.Api.Execute(ctx, nil, "PATCH", apiUrl.String(), nil, folder, []int{http.StatusOK}, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update pathway folder: %w", err)
+	}
+	if err := folderErrors(response.Errors); err != nil {
+		return nil, fmt.Errorf("failed to update pathway folder: %w", err)
+	}
+	if response.Data == nil {
+		return &pathwayFolderDto{ID: folderID, Name: folder.Name, ParentFolderID: folder.ParentFolderID}, nil
+	}
+	return response.Data, nil
+}
+
+func (client *client) DeletePathwayFolder(ctx context.Context, folderID string) error {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.Config.BaseURL,
+		Path:   fmt.Sprintf("/v1/pathway/folders/%s", folderID),
+	}
+
+	_, err := client.Api.Execute(ctx, nil, "DELETE", apiUrl.String(), nil, nil, []int{http.StatusOK}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete pathway folder: %w", err)
+	}
+	return nil
+}
+
+// MovePathway moves a pathway into a folder, or to the top level when folderID is nil. The pathway keeps its id and versions.
+func (client *client) MovePathway(ctx context.Context, pathwayID string, folderID *string) error {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.Config.BaseURL,
+		Path:   "/v1/pathway/folders/move",
+	}
+
+	response := movePathwayResponseDto{}
+	_, err := client.Api.Execute(ctx, nil, "POST", apiUrl.String(), nil, movePathwayDto{PathwayID: pathwayID, FolderID: folderID}, []int{http.StatusOK}, &response)
+	if err != nil {
+		return fmt.Errorf("failed to move pathway: %w", err)
+	}
+	if err := folderErrors(response.Errors); err != nil {
+		return fmt.Errorf("failed to move pathway %s: %w", pathwayID, err)
+	}
+	return nil
+}
+
+func folderErrors(errors *[]errorDto) error {
+	if errors == nil || len(*errors) == 0 {
+		return nil
+	}
+	messages := make([]string, 0, len(*errors))
+	for _, err := range *errors {
+		messages = append(messages, err.Message)
+	}
+	return fmt.Errorf("%s", strings.Join(messages, ". "))
+}
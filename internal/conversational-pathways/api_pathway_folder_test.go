@@ -0,0 +1,87 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jameshiester/terraform-provider-bland/internal/api"
+	"github.com/jameshiester/terraform-provider-bland/internal/config"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestGetPathwayFolder(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://api.bland.ai/v1/pathway/folders",
+		httpmock.NewStringResponder(http.StatusOK, `{"data": [{"folder_id": "f1", "name": "Sales", "parent_folder_id": null}, {"folder_id": "f2", "name": "Leads", "parent_folder_id": "f1"}]}`),
+	)
+
+	c := client{Api: &api.Client{Config: &config.ProviderConfig{BaseURL: "api.bland.ai", APIKey: "123"}}}
+	folder, err := c.GetPathwayFolder(context.Background(), "f2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if folder.Name != "Leads" || folder.ParentFolderID == nil || *folder.ParentFolderID != "f1" {
+		t.Errorf("unexpected folder %+v", folder)
+	}
+
+	_, err = c.GetPathwayFolder(context.Background(), "f3")
+	if !errors.Is(err, api.ErrObjectNotFound) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestMovePathway(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var moved movePathwayDto
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/v1/pathway/folders/move",
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&moved); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{"errors": null}`), nil
+		})
+
+	c := client{Api: &api.Client{Config: &config.ProviderConfig{BaseURL: "api.bland.ai", APIKey: "123"}}}
+	if err := c.MovePathway(context.Background(), "p1", strPtr("f1")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if moved.PathwayID != "p1" || moved.FolderID == nil || *moved.FolderID != "f1" {
+		t.Errorf("unexpected move request %+v", moved)
+	}
+
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/v1/pathway/folders/move",
+		httpmock.NewStringResponder(http.StatusOK, `{"errors": [{"error": "FolderNotFound", "message": "Folder not found"}]}`),
+	)
+	if err := c.MovePathway(context.Background(), "p1", strPtr("missing")); err == nil {
+		t.Errorf("expected error for missing folder")
+	}
+}
+
+func TestFindPathwayFolderByName(t *testing.T) {
+	folders := []pathwayFolderDto{
+		{ID: "f1", Name: "Sales"},
+		{ID: "f2", Name: "Support"},
+		{ID: "f3", Name: "Support"},
+	}
+	folder, err := FindPathwayFolderByName(folders, "Sales")
+	if err != nil || folder.ID != "f1" {
+		t.Errorf("expected folder f1, got %v, %v", folder, err)
+	}
+	if _, err := FindPathwayFolderByName(folders, "Support"); err == nil || !strings.Contains(err.Error(), "f2 and f3") {
+		t.Errorf("expected ambiguous folder error, got %v", err)
+	}
+	if _, err := FindPathwayFolderByName(folders, "Billing"); err == nil {
+		t.Errorf("expected error for missing folder")
+	}
+}
@@ -5,8 +5,10 @@ package pathways
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/jameshiester/terraform-provider-bland/internal/api"
@@ -145,3 +147,155 @@ func TestGetPathwayVersions_HTTPMock(t *testing.T) {
 		t.Errorf("expected is_prev_published for third version to be true")
 	}
 }
+
+func TestFindPromotedVersion(t *testing.T) {
+	versions := []pathwayVersionDto{
+		{VersionNumber: 4, RevisionNumber: 1},
+		{VersionNumber: 3, RevisionNumber: 2, IsStaging: boolPtr(true), IsProduction: boolPtr(false)},
+		{VersionNumber: 2, RevisionNumber: 1, IsProduction: boolPtr(true)},
+	}
+
+	tests := []struct {
+		name        string
+		environment string
+		expectV     int
+		found       bool
+	}{
+		{name: "production", environment: "production", expectV: 2, found: true},
+		{name: "staging", environment: "staging", expectV: 3, found: true},
+		{name: "unknown environment", environment: "dev", expectV: 0, found: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			v, found := FindPromotedVersion(versions, tc.environment)
+			if v != tc.expectV || found != tc.found {
+				t.Errorf("expected (%d, %v), got (%d, %v)", tc.expectV, tc.found, v, found)
+			}
+		})
+	}
+
+	if _, found := FindPromotedVersion([]pathwayVersionDto{{VersionNumber: 1}}, "production"); found {
+		t.Errorf("expected no promoted version")
+	}
+}
+
+func TestFindLatestVersion(t *testing.T) {
+	if _, found := FindLatestVersion(nil); found {
+		t.Errorf("expected no latest version for empty list")
+	}
+	v, found := FindLatestVersion([]pathwayVersionDto{{VersionNumber: 5, RevisionNumber: 2}, {VersionNumber: 4, RevisionNumber: 9}})
+	if !found || v != 5 {
+		t.Errorf("expected (5, true), got (%d, %v)", v, found)
+	}
+}
+
+func TestPublishPathway_HTTPMock(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	pathwayID := "abc123"
+	var body map[string]any
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/v1/pathway/"+pathwayID+"/publish",
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{"data": {"message": "Pathway published"}, "errors": null}`), nil
+		},
+	)
+
+	client := client{Api: &api.Client{Config: &config.ProviderConfig{BaseURL: "api.bland.ai", APIKey: "123"}}}
+	err := client.PublishPathway(context.Background(), pathwayID, 3, "production")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body["version_id"] != float64(3) {
+		t.Errorf("expected version_id 3, got %v", body["version_id"])
+	}
+	if body["environment"] != "production" {
+		t.Errorf("expected environment production, got %v", body["environment"])
+	}
+}
+
+func TestPublishPathway_HTTPMock_Errors(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	pathwayID := "abc123"
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/v1/pathway/"+pathwayID+"/publish",
+		httpmock.NewStringResponder(http.StatusOK, `{"data": null, "errors": [{"error": "INVALID_VERSION", "message": "Version not found"}]}`),
+	)
+
+	client := client{Api: &api.Client{Config: &config.ProviderConfig{BaseURL: "api.bland.ai", APIKey: "123"}}}
+	err := client.PublishPathway(context.Background(), pathwayID, 7, "staging")
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+	if !strings.Contains(err.Error(), "Version not found") {
+		t.Errorf("expected error to contain API message, got %v", err)
+	}
+}
+
+func TestGetPathwayVersion_HTTPMock(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	pathwayID := "abc123"
+	httpmock.RegisterResponder("GET", "https://api.bland.ai/v1/pathway/"+pathwayID+"/version/2",
+		httpmock.NewStringResponder(http.StatusOK, `{"name": "Pathway", "description": "Version two", "nodes": [{"id": "1", "type": "Default", "data": {"name": "Start"}}], "edges": []}`),
+	)
+
+	client := client{Api: &api.Client{Config: &config.ProviderConfig{BaseURL: "api.bland.ai", APIKey: "123"}}}
+	pathway, err := client.GetPathwayVersion(context.Background(), pathwayID, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pathway.ID != pathwayID {
+		t.Errorf("expected id %s, got %s", pathwayID, pathway.ID)
+	}
+	if pathway.Description != "Version two" {
+		t.Errorf("expected description 'Version two', got %s", pathway.Description)
+	}
+	if len(pathway.Nodes) != 1 {
+		t.Errorf("expected 1 node, got %d", len(pathway.Nodes))
+	}
+}
+
+func TestUpdatePathway_HTTPMock_PostCallActions(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	pathwayID := "abc123"
+	httpmock.RegisterResponder("GET", "https://api.bland.ai/v1/pathway/"+pathwayID,
+		httpmock.NewStringResponder(http.StatusOK, `{"pathway_id": "abc123", "name": "Test", "description": "Test", "nodes": [], "edges": []}`),
+	)
+	var body map[string]any
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/convo_pathway/update",
+		func(req *http.Request) (*http.Response, error) {
+			body = map[string]any{}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{}`), nil
+		},
+	)
+
+	client := client{Api: &api.Client{Config: &config.ProviderConfig{BaseURL: "api.bland.ai", APIKey: "123"}}}
+	_, err := client.UpdatePathway(context.Background(), pathwayID, updatePathwayDto{ID: pathwayID, Name: "Test"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := body["post_call_actions"]; ok {
+		t.Errorf("expected unset post_call_actions to be omitted, got %v", body["post_call_actions"])
+	}
+
+	cleared := []string{}
+	_, err = client.UpdatePathway(context.Background(), pathwayID, updatePathwayDto{ID: pathwayID, Name: "Test", PostCallActions: &cleared})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actions, ok := body["post_call_actions"].([]any); !ok || len(actions) != 0 {
+		t.Errorf("expected an empty post_call_actions list, got %v", body["post_call_actions"])
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/jameshiester/terraform-provider-bland/internal/api"
+	"github.com/jameshiester/terraform-provider-bland/internal/constants"
+)
+
+// GetTool fetches a custom tool, used to validate the inputs bound by Custom Tool nodes.
+func (client *client) GetTool(ctx context.Context, toolID string) (*toolDto, error) {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.Config.BaseURL,
+		Path:   fmt.Sprintf("/v1/tools/%s", toolID),
+	}
+
+	response := getToolResponseDto{}
+	resp, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusNotFound}, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tool: %w", err)
+	}
+	if resp.HttpResponse.StatusCode == http.StatusNotFound || response.Data == nil {
+		return nil, api.WrapIntoProviderError(nil, api.ErrorCode(constants.ERROR_OBJECT_NOT_FOUND), fmt.Sprintf("Tool '%s' not found", toolID))
+	}
+	return response.Data, nil
+}
+
+// MissingToolInputs returns the required inputs of the tool that are not bound, in the order the tool declares them.
+func MissingToolInputs(tool toolDto, inputs map[string]string) []string {
+	missing := make([]string, 0)
+	for _, name := range tool.Tool.InputSchema.Required {
+		if _, ok := inputs[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,95 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jameshiester/terraform-provider-bland/internal/api"
+	"github.com/jameshiester/terraform-provider-bland/internal/config"
+	"github.com/jarcoal/httpmock"
+)
+
+const testToolResponse = `{"status": "success", "data": {"tool_id": "TL-123", "label": "Lookup order", "tool": {"name": "LookupOrder", "input_schema": {"type": "object", "properties": {"order_id": {"type": "string"}, "email": {"type": "string"}, "notes": {"type": "string"}}, "required": ["order_id", "email"]}}}}`
+
+func TestGetTool_HTTPMock(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://api.bland.ai/v1/tools/TL-123",
+		httpmock.NewStringResponder(http.StatusOK, testToolResponse))
+	httpmock.RegisterResponder("GET", "https://api.bland.ai/v1/tools/TL-404",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"status": "error", "message": "Tool not found"}`))
+
+	client := client{Api: &api.Client{Config: &config.ProviderConfig{BaseURL: "api.bland.ai", APIKey: "123"}}}
+	tool, err := client.GetTool(context.Background(), "TL-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tool.Tool.Name != "LookupOrder" || len(tool.Tool.InputSchema.Required) != 2 {
+		t.Errorf("unexpected tool: %+v", tool)
+	}
+
+	_, err = client.GetTool(context.Background(), "TL-404")
+	if !errors.Is(err, api.ErrObjectNotFound) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestMissingToolInputs(t *testing.T) {
+	tool := toolDto{Tool: toolDefinitionDto{InputSchema: toolInputSchemaDto{Required: []string{"order_id", "email"}}}}
+
+	missing := MissingToolInputs(tool, map[string]string{"order_id": "{{order_id}}", "notes": "none"})
+	if len(missing) != 1 || missing[0] != "email" {
+		t.Errorf("expected email to be missing, got %v", missing)
+	}
+	if missing := MissingToolInputs(tool, map[string]string{"order_id": "1", "email": "a@b.c"}); len(missing) != 0 {
+		t.Errorf("expected no missing inputs, got %v", missing)
+	}
+}
+
+func TestValidateToolNodes(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://api.bland.ai/v1/tools/TL-123",
+		httpmock.NewStringResponder(http.StatusOK, testToolResponse))
+
+	r := &ConversationalPathwayResource{
+		PathwayClient: client{Api: &api.Client{Config: &config.ProviderConfig{BaseURL: "api.bland.ai", APIKey: "123"}}},
+	}
+	plan := ConversationalPathwayModel{
+		Nodes: []ConversationalPathwayNodeModel{
+			{
+				ID:   types.StringValue("1"),
+				Type: types.StringValue(PATHWAY_NODE_TYPE_CUSTOM_TOOL),
+				Data: ConversationalPathwayNodeDataModel{
+					Name:       types.StringValue("Lookup"),
+					ToolID:     types.StringValue("TL-123"),
+					ToolInputs: map[string]types.String{"order_id": types.StringValue("{{order_id}}")},
+				},
+			},
+			{
+				ID:   types.StringValue("2"),
+				Type: types.StringValue(PATHWAY_NODE_TYPE_CUSTOM_TOOL),
+				Data: ConversationalPathwayNodeDataModel{Name: types.StringValue("Broken")},
+			},
+		},
+	}
+
+	diags := r.validateToolNodes(context.Background(), plan)
+	if diags.ErrorsCount() != 2 {
+		t.Fatalf("expected 2 errors, got %v", diags)
+	}
+	if diags[0].Summary() != "Required tool inputs not bound" || diags[1].Summary() != "Missing tool ID" {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+	if info := httpmock.GetCallCountInfo(); info["GET https://api.bland.ai/v1/tools/TL-123"] != 1 {
+		t.Errorf("expected tool to be fetched once, got %v", info)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -45,7 +46,7 @@ func (r *ConversationalPathwayDataSource) Metadata(ctx context.Context, req data
 func (d *ConversationalPathwayDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "Data source to retrieve a specific conversational pathway by `id`.",
+		MarkdownDescription: "Data source to retrieve a specific conversational pathway by `id`. By default the current draft is returned, use `version_number` or `environment` to read a specific version.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -60,6 +61,40 @@ func (d *ConversationalPathwayDataSource) Schema(ctx context.Context, req dataso
 				MarkdownDescription: "A description of the conversational pathway.",
 				Computed:            true,
 			},
+			"version_number": schema.Int64Attribute{
+				MarkdownDescription: "Version of the pathway to read. When `environment` is set this is the version currently promoted to that environment.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.ConflictsWith(path.MatchRoot("environment")),
+				},
+			},
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "Read the version currently promoted to this environment. One of `staging` or `production`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(PATHWAY_ENVIRONMENT_STAGING, PATHWAY_ENVIRONMENT_PRODUCTION),
+					stringvalidator.ConflictsWith(path.MatchRoot("version_number")),
+				},
+			},
+			"post_call_actions": schema.ListAttribute{
+				MarkdownDescription: "Actions run after a call on the pathway ends.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"folder_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the folder the pathway is filed in, if any.",
+				Computed:            true,
+			},
+			"graph_mermaid": schema.StringAttribute{
+				MarkdownDescription: "Mermaid flowchart of the pathway. Nodes are labelled by name and type, start and global nodes are highlighted and edges are labelled by their label and conditions.",
+				Computed:            true,
+			},
+			"graph_dot": schema.StringAttribute{
+				MarkdownDescription: "Graphviz DOT digraph of the pathway, with the same labels and highlighting as `graph_mermaid`.",
+				Computed:            true,
+			},
 			"nodes": schema.ListNestedAttribute{
 				MarkdownDescription: "Data about all the nodes in the pathway.",
 				Computed:            true,
@@ -291,6 +326,11 @@ func (d *ConversationalPathwayDataSource) Schema(ctx context.Context, req dataso
 										"token": schema.StringAttribute{
 											MarkdownDescription: "Auth token.",
 											Computed:            true,
+											Sensitive:           true,
+										},
+										"token_secret": schema.StringAttribute{
+											MarkdownDescription: "Name of the `bland_secret` holding the auth token.",
+											Computed:            true,
 										},
 										"encode": schema.BoolAttribute{
 											MarkdownDescription: "Whether to encode the token.",
@@ -301,6 +341,7 @@ func (d *ConversationalPathwayDataSource) Schema(ctx context.Context, req dataso
 								"body": schema.StringAttribute{
 									MarkdownDescription: "Body for the node.",
 									Computed:            true,
+									Sensitive:           true,
 								},
 								"headers": schema.ListNestedAttribute{
 									MarkdownDescription: "Headers for the node.",
@@ -314,6 +355,11 @@ func (d *ConversationalPathwayDataSource) Schema(ctx context.Context, req dataso
 											"value": schema.StringAttribute{
 												MarkdownDescription: "Header value.",
 												Computed:            true,
+												Sensitive:           true,
+											},
+											"secret": schema.StringAttribute{
+												MarkdownDescription: "Name of the `bland_secret` holding the header value.",
+												Computed:            true,
 											},
 										},
 									},
@@ -366,6 +412,19 @@ func (d *ConversationalPathwayDataSource) Schema(ctx context.Context, req dataso
 									MarkdownDescription: "Maximum number of retries for the node.",
 									Computed:            true,
 								},
+								"tool_id": schema.StringAttribute{
+									MarkdownDescription: "ID of the custom tool invoked by a `Custom Tool` node.",
+									Computed:            true,
+								},
+								"tool_inputs": schema.MapAttribute{
+									MarkdownDescription: "Values bound to the inputs of the custom tool, keyed by input name.",
+									ElementType:         types.StringType,
+									Computed:            true,
+								},
+								"speech": schema.StringAttribute{
+									MarkdownDescription: "Text spoken while the custom tool is executing.",
+									Computed:            true,
+								},
 							},
 						},
 					},
@@ -448,6 +507,48 @@ func (d *ConversationalPathwayDataSource) Schema(ctx context.Context, req dataso
 						MarkdownDescription: "Global prompt for the pathway.",
 						Computed:            true,
 					},
+					"model_options": schema.SingleNestedAttribute{
+						MarkdownDescription: "Default model options for nodes of the pathway.",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"model_type": schema.StringAttribute{
+								MarkdownDescription: "Type of the model.",
+								Computed:            true,
+							},
+							"interruption_threshold": schema.StringAttribute{
+								MarkdownDescription: "Interruption threshold for the model.",
+								Computed:            true,
+							},
+							"temperature": schema.Float32Attribute{
+								MarkdownDescription: "Temperature setting for the model.",
+								Computed:            true,
+							},
+							"skip_user_response": schema.BoolAttribute{
+								MarkdownDescription: "Whether to skip user response.",
+								Computed:            true,
+							},
+							"block_interruptions": schema.BoolAttribute{
+								MarkdownDescription: "Whether to block interruptions.",
+								Computed:            true,
+							},
+						},
+					},
+					"voice": schema.StringAttribute{
+						MarkdownDescription: "Voice used on calls following the pathway.",
+						Computed:            true,
+					},
+					"first_sentence": schema.StringAttribute{
+						MarkdownDescription: "First sentence spoken at the start of the call.",
+						Computed:            true,
+					},
+					"kb": schema.StringAttribute{
+						MarkdownDescription: "Default knowledge base for nodes of the pathway.",
+						Computed:            true,
+					},
+					"kb_tool": schema.StringAttribute{
+						MarkdownDescription: "Default knowledge base tool for nodes of the pathway.",
+						Computed:            true,
+					},
 				},
 			},
 		},
@@ -488,7 +589,28 @@ func (d *ConversationalPathwayDataSource) Read(ctx context.Context, req datasour
 	state.Name = types.StringValue(state.Name.ValueString())
 	state.Description = types.StringValue(state.Description.ValueString())
 
-	pathway, err := d.ApplicationClient.GetPathway(ctx, state.ID.ValueString())
+	if !state.Environment.IsNull() {
+		versions, err := d.ApplicationClient.GetPathwayVersions(ctx, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error fetching versions for %s", d.FullTypeName()), err.Error())
+			return
+		}
+		versionNumber, found := FindPromotedVersion(versions, state.Environment.ValueString())
+		if !found {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", d.FullTypeName()), fmt.Sprintf("No version of pathway %s is promoted to %s", state.ID.ValueString(), state.Environment.ValueString()))
+			return
+		}
+		state.VersionNumber = types.Int64Value(int64(versionNumber))
+	}
+
+	var pathway *pathwayDto
+	var err error
+	if !state.VersionNumber.IsNull() && !state.VersionNumber.IsUnknown() {
+		pathway, err = d.ApplicationClient.GetPathwayVersion(ctx, state.ID.ValueString(), int(state.VersionNumber.ValueInt64()))
+	} else {
+		state.VersionNumber = types.Int64Null()
+		pathway, err = d.ApplicationClient.GetPathway(ctx, state.ID.ValueString())
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", d.FullTypeName()), err.Error())
 		return
@@ -505,6 +627,10 @@ func (d *ConversationalPathwayDataSource) Read(ctx context.Context, req datasour
 	state.Nodes = model.Nodes
 	state.Edges = model.Edges
 	state.GlobalConfig = model.GlobalConfig
+	state.PostCallActions = model.PostCallActions
+	state.FolderID = model.FolderID
+	state.GraphMermaid = types.StringValue(RenderPathwayMermaid(*model))
+	state.GraphDot = types.StringValue(RenderPathwayDot(*model))
 	diags := resp.State.Set(ctx, &state)
 
 	tflog.Debug(ctx, fmt.Sprintf("READ DATASOURCE CONVERSATIONAL PATHWAYS END: %s", d.FullTypeName()))
@@ -103,3 +103,40 @@ func TestUnitConversationalPathwayDataSource_Validate_Read(t *testing.T) {
 		},
 	})
 }
+
+func TestUnitConversationalPathwayDataSource_Validate_Read_Environment(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bland.ai/v1/pathway/123/versions`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/datasource/Validate_Read_Environment/get_pathway_versions.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bland.ai/v1/pathway/123/version/1`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/datasource/Validate_Read_Environment/get_pathway_version.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "bland_conversational_pathway" "pathway" {
+					id          = "123"
+					environment = "production"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bland_conversational_pathway.pathway", "id", "123"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathway.pathway", "environment", "production"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathway.pathway", "version_number", "1"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathway.pathway", "nodes.#", "1"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathway.pathway", "nodes.0.data.text", "Hello from production"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,134 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jameshiester/terraform-provider-bland/internal/api"
+	utils "github.com/jameshiester/terraform-provider-bland/internal/util"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ConversationalPathwayVersionsDataSource{}
+
+func NewConversationalPathwayVersionsDataSource() datasource.DataSource {
+	return &ConversationalPathwayVersionsDataSource{
+		TypeInfo: utils.TypeInfo{
+			TypeName: "conversational_pathway_versions",
+		},
+	}
+}
+
+func (d *ConversationalPathwayVersionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	d.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := utils.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = d.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (d *ConversationalPathwayVersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to list all versions of a conversational pathway, newest first.",
+
+		Attributes: map[string]schema.Attribute{
+			"pathway_id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the conversational pathway.",
+				Required:            true,
+			},
+			"versions": schema.ListNestedAttribute{
+				MarkdownDescription: "Versions of the pathway ordered by version and revision number, newest first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version_number": schema.Int64Attribute{
+							MarkdownDescription: "Version number.",
+							Computed:            true,
+						},
+						"revision_number": schema.Int64Attribute{
+							MarkdownDescription: "Revision number within the version.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the version.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Creation timestamp of the version.",
+							Computed:            true,
+						},
+						"source_version_number": schema.Int64Attribute{
+							MarkdownDescription: "Version this version was created from.",
+							Computed:            true,
+						},
+						"is_staging": schema.BoolAttribute{
+							MarkdownDescription: "Whether the version is promoted to staging.",
+							Computed:            true,
+						},
+						"is_production": schema.BoolAttribute{
+							MarkdownDescription: "Whether the version is promoted to production.",
+							Computed:            true,
+						},
+						"is_published": schema.BoolAttribute{
+							MarkdownDescription: "Whether the version has been published.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ConversationalPathwayVersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	_, exitContext := utils.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.ApplicationClient = newPathwayClient(client.Api)
+}
+
+func (d *ConversationalPathwayVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	var state ConversationalPathwayVersionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versions, err := d.ApplicationClient.GetPathwayVersions(ctx, state.PathwayID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", d.FullTypeName()), err.Error())
+		return
+	}
+
+	state.Versions = make([]ConversationalPathwayVersionModel, 0, len(versions))
+	for _, version := range versions {
+		state.Versions = append(state.Versions, ConvertFromPathwayVersionDto(version))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jameshiester/terraform-provider-bland/internal/mocks"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestUnitConversationalPathwayVersionsDataSource_Validate_Read(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bland.ai/v1/pathway/123/versions`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/datasource/Validate_Read_Versions/get_pathway_versions.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "bland_conversational_pathway_versions" "versions" {
+					pathway_id = "123"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bland_conversational_pathway_versions.versions", "pathway_id", "123"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathway_versions.versions", "versions.#", "2"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathway_versions.versions", "versions.0.version_number", "2"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathway_versions.versions", "versions.0.revision_number", "1"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathway_versions.versions", "versions.0.name", "Version 2"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathway_versions.versions", "versions.0.created_at", "2025-07-23T00:16:28.052Z"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathway_versions.versions", "versions.0.source_version_number", "1"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathway_versions.versions", "versions.0.is_staging", "true"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathway_versions.versions", "versions.0.is_production", "false"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathway_versions.versions", "versions.0.is_published", "false"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathway_versions.versions", "versions.1.version_number", "1"),
+					resource.TestCheckNoResourceAttr("data.bland_conversational_pathway_versions.versions", "versions.1.source_version_number"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathway_versions.versions", "versions.1.is_production", "true"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathway_versions.versions", "versions.1.is_published", "true"),
+				),
+			},
+		},
+	})
+}
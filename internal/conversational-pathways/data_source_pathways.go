@@ -0,0 +1,304 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jameshiester/terraform-provider-bland/internal/api"
+	utils "github.com/jameshiester/terraform-provider-bland/internal/util"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ConversationalPathwaysDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &ConversationalPathwaysDataSource{}
+
+func NewConversationalPathwaysDataSource() datasource.DataSource {
+	return &ConversationalPathwaysDataSource{
+		TypeInfo: utils.TypeInfo{
+			TypeName: "conversational_pathways",
+		},
+	}
+}
+
+// PathwaySummaryFilter selects pathways listed by the pathway list data source. Unset fields match every pathway.
+type PathwaySummaryFilter struct {
+	NameRegex    *regexp.Regexp
+	FolderID     *string
+	UpdatedAfter *time.Time
+}
+
+func (d *ConversationalPathwaysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	d.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := utils.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = d.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (d *ConversationalPathwaysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to list the conversational pathways of the account, optionally filtered by name, folder or last update. " +
+			"Only a summary of each pathway is returned, use the `bland_conversational_pathway` data source to read its nodes and edges.",
+
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Regular expression ([RE2 syntax](https://github.com/google/re2/wiki/Syntax)) the name of a pathway must match.",
+				Optional:            true,
+			},
+			"folder_id": schema.StringAttribute{
+				MarkdownDescription: "Only list pathways filed directly in the folder with this ID.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("folder_name")),
+				},
+			},
+			"folder_name": schema.StringAttribute{
+				MarkdownDescription: "Only list pathways filed directly in the folder with this name. The name must identify a single folder.",
+				Optional:            true,
+			},
+			"updated_after": schema.StringAttribute{
+				MarkdownDescription: "Only list pathways updated after this [RFC 3339](https://datatracker.ietf.org/doc/html/rfc3339) timestamp, e.g. `2025-01-01T00:00:00Z`.",
+				Optional:            true,
+			},
+			"include_versions": schema.BoolAttribute{
+				MarkdownDescription: "Whether to read the version numbers of each listed pathway. Reading them takes one request per listed pathway, set to `false` to skip them and leave the version numbers null. Defaults to `true`.",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the listed pathways, in the same order as `pathways`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"pathways": schema.ListNestedAttribute{
+				MarkdownDescription: "Pathways matching all filters, ordered by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the pathway.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the pathway.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the pathway.",
+							Computed:            true,
+						},
+						"folder_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the folder the pathway is filed in, if any.",
+							Computed:            true,
+						},
+						"folder_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the folder the pathway is filed in, if any.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Creation timestamp of the pathway.",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp of the last update of the pathway.",
+							Computed:            true,
+						},
+						"latest_version_number": schema.Int64Attribute{
+							MarkdownDescription: "Highest version number of the pathway, unless `include_versions` is `false`.",
+							Computed:            true,
+						},
+						"staging_version_number": schema.Int64Attribute{
+							MarkdownDescription: "Version number promoted to staging, if any, unless `include_versions` is `false`.",
+							Computed:            true,
+						},
+						"production_version_number": schema.Int64Attribute{
+							MarkdownDescription: "Version number promoted to production, if any, unless `include_versions` is `false`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ConversationalPathwaysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	_, exitContext := utils.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.ApplicationClient = newPathwayClient(client.Api)
+}
+
+func (d *ConversationalPathwaysDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	var config ConversationalPathwaysDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.NameRegex.IsNull() && !config.NameRegex.IsUnknown() {
+		if _, err := regexp.Compile(config.NameRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid regular expression", err.Error())
+		}
+	}
+	if !config.UpdatedAfter.IsNull() && !config.UpdatedAfter.IsUnknown() {
+		if _, err := time.Parse(time.RFC3339, config.UpdatedAfter.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("updated_after"), "Invalid timestamp", fmt.Sprintf("updated_after must be an RFC 3339 timestamp: %s", err.Error()))
+		}
+	}
+}
+
+func (d *ConversationalPathwaysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	var state ConversationalPathwaysDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := PathwaySummaryFilter{FolderID: state.FolderID.ValueStringPointer()}
+	if !state.NameRegex.IsNull() {
+		nameRegex, err := regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid regular expression", err.Error())
+			return
+		}
+		filter.NameRegex = nameRegex
+	}
+	if !state.UpdatedAfter.IsNull() {
+		updatedAfter, err := time.Parse(time.RFC3339, state.UpdatedAfter.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("updated_after"), "Invalid timestamp", fmt.Sprintf("updated_after must be an RFC 3339 timestamp: %s", err.Error()))
+			return
+		}
+		filter.UpdatedAfter = &updatedAfter
+	}
+
+	folders, err := d.ApplicationClient.GetPathwayFolders(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", d.FullTypeName()), err.Error())
+		return
+	}
+	if !state.FolderName.IsNull() {
+		folder, err := FindPathwayFolderByName(folders, state.FolderName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("folder_name"), "Pathway folder not found", err.Error())
+			return
+		}
+		filter.FolderID = &folder.ID
+	}
+
+	pathways, err := d.ApplicationClient.ListPathways(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", d.FullTypeName()), err.Error())
+		return
+	}
+	pathways = FilterPathwaySummaries(pathways, filter)
+	tflog.Debug(ctx, fmt.Sprintf("%d pathways match the filters of %s", len(pathways), d.FullTypeName()))
+
+	folderNames := make(map[string]string, len(folders))
+	for _, folder := range folders {
+		folderNames[folder.ID] = folder.Name
+	}
+
+	state.IDs = make([]types.String, 0, len(pathways))
+	state.Pathways = make([]ConversationalPathwaySummaryModel, 0, len(pathways))
+	for _, pathway := range pathways {
+		// Versions take one request per pathway, they are skipped when not wanted.
+		var versions []pathwayVersionDto
+		if state.IncludeVersions.IsNull() || state.IncludeVersions.ValueBool() {
+			versions, err = d.ApplicationClient.GetPathwayVersions(ctx, pathway.ID)
+			if err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("Client error fetching versions of pathway %s", pathway.ID), err.Error())
+				return
+			}
+		}
+		summary := ConvertFromPathwaySummaryDto(pathway, versions)
+		if pathway.FolderID != nil {
+			if name, ok := folderNames[*pathway.FolderID]; ok {
+				summary.FolderName = types.StringValue(name)
+			}
+		}
+		state.IDs = append(state.IDs, summary.ID)
+		state.Pathways = append(state.Pathways, summary)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// FilterPathwaySummaries returns the pathways matching all filters, ordered by name and id.
+func FilterPathwaySummaries(pathways []pathwaySummaryDto, filter PathwaySummaryFilter) []pathwaySummaryDto {
+	filtered := make([]pathwaySummaryDto, 0, len(pathways))
+	for _, pathway := range pathways {
+		if filter.NameRegex != nil && !filter.NameRegex.MatchString(pathway.Name) {
+			continue
+		}
+		if filter.FolderID != nil && (pathway.FolderID == nil || *pathway.FolderID != *filter.FolderID) {
+			continue
+		}
+		if filter.UpdatedAfter != nil {
+			updatedAt, err := time.Parse(time.RFC3339, pathway.UpdatedAt)
+			if err != nil || !updatedAt.After(*filter.UpdatedAfter) {
+				continue
+			}
+		}
+		filtered = append(filtered, pathway)
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if filtered[i].Name != filtered[j].Name {
+			return filtered[i].Name < filtered[j].Name
+		}
+		return filtered[i].ID < filtered[j].ID
+	})
+	return filtered
+}
+
+// FindPathwayFolderByName returns the only folder with the given name.
+func FindPathwayFolderByName(folders []pathwayFolderDto, name string) (*pathwayFolderDto, error) {
+	var found *pathwayFolderDto
+	for i := range folders {
+		if folders[i].Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("folders %s and %s are both named '%s', use folder_id instead", found.ID, folders[i].ID, name)
+		}
+		found = &folders[i]
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no pathway folder is named '%s'", name)
+	}
+	return found, nil
+}
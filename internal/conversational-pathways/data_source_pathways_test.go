@@ -0,0 +1,120 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jameshiester/terraform-provider-bland/internal/mocks"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestUnitConversationalPathwaysDataSource_Validate_Read(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bland.ai/v1/pathway`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/datasource/Validate_Read_List/get_pathways.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bland.ai/v1/pathway/folders`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/datasource/Validate_Read_List/get_folders.json").String()), nil
+		})
+
+	versionRequests := 0
+	httpmock.RegisterResponder("GET", `=~^https://api.bland.ai/v1/pathway/\d+/versions\z`,
+		func(req *http.Request) (*http.Response, error) {
+			versionRequests++
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/datasource/Validate_Read_List/get_pathway_versions.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "bland_conversational_pathways" "all" {
+					include_versions = false
+				}
+
+				data "bland_conversational_pathways" "support" {
+					name_regex    = "^Support"
+					folder_name   = "Support"
+					updated_after = "2025-01-01T00:00:00Z"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bland_conversational_pathways.all", "pathways.#", "3"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathways.all", "ids.#", "3"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathways.all", "ids.0", "456"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathways.all", "pathways.0.name", "Sales Qualification"),
+					resource.TestCheckNoResourceAttr("data.bland_conversational_pathways.all", "pathways.0.folder_id"),
+					resource.TestCheckNoResourceAttr("data.bland_conversational_pathways.all", "pathways.0.latest_version_number"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathways.all", "pathways.1.name", "Support Escalation"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathways.support", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathways.support", "pathways.0.id", "123"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathways.support", "pathways.0.description", "Routes support calls"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathways.support", "pathways.0.folder_id", "folder-1"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathways.support", "pathways.0.folder_name", "Support"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathways.support", "pathways.0.updated_at", "2025-07-23T00:16:28.052Z"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathways.support", "pathways.0.latest_version_number", "3"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathways.support", "pathways.0.staging_version_number", "3"),
+					resource.TestCheckResourceAttr("data.bland_conversational_pathways.support", "pathways.0.production_version_number", "2"),
+					func(_ *terraform.State) error {
+						if versionRequests != 1 {
+							return fmt.Errorf("expected versions to be read only for the pathway listed without include_versions = false, got %d requests", versionRequests)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestUnitConversationalPathwaysDataSource_Validate_Invalid_Filters(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "bland_conversational_pathways" "invalid" {
+					name_regex = "Support("
+				}
+				`,
+				ExpectError: regexp.MustCompile("Invalid regular expression"),
+			},
+			{
+				Config: `
+				data "bland_conversational_pathways" "invalid" {
+					updated_after = "yesterday"
+				}
+				`,
+				ExpectError: regexp.MustCompile("Invalid timestamp"),
+			},
+			{
+				// Timestamps only known during apply are checked when the data source is read.
+				Config: `
+				resource "terraform_data" "updated_after" {
+					input = "yesterday"
+				}
+
+				data "bland_conversational_pathways" "invalid" {
+					updated_after = terraform_data.updated_after.output
+				}
+				`,
+				ExpectError: regexp.MustCompile("Invalid timestamp"),
+			},
+		},
+	})
+}
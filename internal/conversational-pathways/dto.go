@@ -9,10 +9,11 @@ import (
 )
 
 type createPathwayDto struct {
-	Name        string           `json:"name"`
-	Description string           `json:"description"`
-	Nodes       []pathwayNodeDto `json:"nodes"`
-	Edges       []pathwayEdgeDto `json:"edges"`
+	Name            string           `json:"name"`
+	Description     string           `json:"description"`
+	Nodes           []pathwayNodeDto `json:"nodes"`
+	Edges           []pathwayEdgeDto `json:"edges"`
+	PostCallActions []string         `json:"post_call_actions,omitempty"`
 }
 
 type updatePathwayDto struct {
@@ -23,27 +24,45 @@ type updatePathwayDto struct {
 	Edges           []pathwayEdgeDto `json:"edges"`
 	Revision        int              `json:"revision_number"`
 	Version         int              `json:"version_number"`
-	PostCallActions []string         `json:"post_call_actions"`
+	PostCallActions *[]string        `json:"post_call_actions,omitempty"`
 }
 
 type pathwayDto struct {
-	ID          string           `json:"pathway_id"`
-	Name        string           `json:"name"`
-	Description string           `json:"description"`
-	Nodes       []pathwayNodeDto `json:"nodes"`
-	Edges       []pathwayEdgeDto `json:"edges"`
+	ID              string           `json:"pathway_id"`
+	Name            string           `json:"name"`
+	Description     string           `json:"description"`
+	Nodes           []pathwayNodeDto `json:"nodes"`
+	Edges           []pathwayEdgeDto `json:"edges"`
+	PostCallActions []string         `json:"post_call_actions"`
+	FolderID        *string          `json:"folder_id,omitempty"`
+}
+
+type pathwaySummaryDto struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	FolderID    *string `json:"folder_id"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
 }
 
 type getPathwayDto struct {
-	ID          string      `json:"pathway_id"`
-	Name        string      `json:"name"`
-	Description string      `json:"description"`
-	Nodes       NodesOrBool `json:"nodes"`
-	Edges       EdgesOrBool `json:"edges"`
+	ID              string      `json:"pathway_id"`
+	Name            string      `json:"name"`
+	Description     string      `json:"description"`
+	Nodes           NodesOrBool `json:"nodes"`
+	Edges           EdgesOrBool `json:"edges"`
+	PostCallActions []string    `json:"post_call_actions"`
+	FolderID        *string     `json:"folder_id"`
 }
 
 type pathwayGlobalConfigDto struct {
-	GlobalPrompt string `json:"globalPrompt"`
+	GlobalPrompt  *string         `json:"globalPrompt,omitempty"`
+	ModelOptions  *modelOptionDto `json:"modelOptions,omitempty"`
+	Voice         *string         `json:"voice,omitempty"`
+	FirstSentence *string         `json:"firstSentence,omitempty"`
+	KnowledgeBase *string         `json:"kb,omitempty"`
+	KbTool        *string         `json:"kbTool,omitempty"`
 }
 
 type pathwayNodeDto struct {
@@ -101,6 +120,9 @@ type pathwayNodeDataDto struct {
 	FallbackNodeId   *string                           `json:"fallbackNodeId,omitempty"`
 	TimeoutValue     *int                              `json:"timeoutValue,omitempty"`
 	MaxRetries       *int                              `json:"max_retries,omitempty"`
+	ToolID           *string                           `json:"toolId,omitempty"`
+	ToolInputs       *map[string]string                `json:"toolInputs,omitempty"`
+	Speech           *string                           `json:"speech,omitempty"`
 }
 
 type AuthDto struct {
@@ -117,6 +139,27 @@ type modelOptionDto struct {
 	BlockInterruptions    *bool    `json:"block_interruptions,omitempty"`
 }
 
+type toolInputSchemaDto struct {
+	Required []string `json:"required"`
+}
+
+type toolDefinitionDto struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	InputSchema toolInputSchemaDto `json:"input_schema"`
+}
+
+type toolDto struct {
+	ID    string            `json:"tool_id"`
+	Label string            `json:"label"`
+	Tool  toolDefinitionDto `json:"tool"`
+}
+
+type getToolResponseDto struct {
+	Status string   `json:"status"`
+	Data   *toolDto `json:"data"`
+}
+
 type createPathwayResponseData struct {
 	ID string `json:"pathway_id"`
 }
@@ -151,6 +194,48 @@ type pathwayVersionDto struct {
 	IsPrevPublished     *bool  `json:"is_prev_published,omitempty"`
 }
 
+type publishPathwayDto struct {
+	VersionNumber int    `json:"version_id"`
+	Environment   string `json:"environment"`
+}
+
+type publishPathwayResponseDto struct {
+	Errors *[]errorDto `json:"errors,omitempty"`
+}
+
+type createPathwayChatDto struct {
+	PathwayID     string            `json:"pathway_id"`
+	VersionNumber *int              `json:"version_number,omitempty"`
+	StartNodeID   string            `json:"start_node_id,omitempty"`
+	RequestData   map[string]string `json:"request_data,omitempty"`
+}
+
+type createPathwayChatDataDto struct {
+	ChatID string `json:"chat_id"`
+}
+
+type createPathwayChatResponseDto struct {
+	Errors *[]errorDto               `json:"errors,omitempty"`
+	Data   *createPathwayChatDataDto `json:"data"`
+}
+
+type pathwayChatMessageDto struct {
+	Message string `json:"message"`
+}
+
+type pathwayChatDto struct {
+	ChatID             string         `json:"chat_id"`
+	AssistantResponses []string       `json:"assistant_responses"`
+	CurrentNodeID      string         `json:"current_node_id"`
+	CurrentNodeName    string         `json:"current_node_name"`
+	Variables          map[string]any `json:"variables"`
+}
+
+type pathwayChatResponseDto struct {
+	Errors *[]errorDto     `json:"errors,omitempty"`
+	Data   *pathwayChatDto `json:"data"`
+}
+
 // Custom type for nodes that can be a boolean or an array
 // If boolean, will be nil. If array, will be the array.
 type NodesOrBool []pathwayNodeDto
@@ -241,3 +326,38 @@ type EdgeConditionDto struct {
 	IsGroup  bool   `json:"isGroup"`
 	Operator string `json:"operator"`
 }
+
+type pathwayFolderDto struct {
+	ID             string  `json:"folder_id"`
+	Name           string  `json:"name"`
+	ParentFolderID *string `json:"parent_folder_id"`
+}
+
+type createPathwayFolderDto struct {
+	Name           string  `json:"name"`
+	ParentFolderID *string `json:"parent_folder_id,omitempty"`
+}
+
+type updatePathwayFolderDto struct {
+	Name           string  `json:"name"`
+	ParentFolderID *string `json:"parent_folder_id"`
+}
+
+type pathwayFolderResponseDto struct {
+	Errors *[]errorDto       `json:"errors,omitempty"`
+	Data   *pathwayFolderDto `json:"data"`
+}
+
+type pathwayFoldersResponseDto struct {
+	Errors *[]errorDto        `json:"errors,omitempty"`
+	Data   []pathwayFolderDto `json:"data"`
+}
+
+type movePathwayDto struct {
+	PathwayID string  `json:"pathway_id"`
+	FolderID  *string `json:"folder_id"`
+}
+
+type movePathwayResponseDto struct {
+	Errors *[]errorDto `json:"errors,omitempty"`
+}
@@ -0,0 +1,93 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	utils "github.com/jameshiester/terraform-provider-bland/internal/util"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &PathwayFragmentFunction{}
+
+func NewPathwayFragmentFunction() function.Function {
+	return &PathwayFragmentFunction{
+		TypeInfo: utils.TypeInfo{
+			TypeName: "pathway_fragment",
+		},
+	}
+}
+
+func (f *PathwayFragmentFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, f.TypeInfo, req)
+	defer exitContext()
+
+	resp.Name = f.TypeName
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.Name))
+}
+
+func (f *PathwayFragmentFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	_, exitContext := utils.EnterRequestContext(ctx, f.TypeInfo, req)
+	defer exitContext()
+
+	resp.Definition = function.Definition{
+		Summary: "Namespaces a reusable set of pathway nodes and edges.",
+		MarkdownDescription: "Namespaces a reusable sub-flow, such as identity verification, so that it can be concatenated into the `nodes` and `edges` of a `bland_conversational_pathway`. " +
+			"Node and edge ids are prefixed, and edges, routes, fallbacks and response pathways targeting an exit are bound to a node of the pathway. " +
+			"Returns an object with the namespaced `nodes` and `edges` and the `entry_node_id` to connect the pathway to.\n\n" +
+			"Fails when ids of the fragment are duplicated, when a node references a node that is neither part of the fragment nor a bound exit, " +
+			"or when a prefixed id collides with a node an exit is bound to. Ids colliding across fragments are reported by `bland_conversational_pathway`.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "fragment",
+				MarkdownDescription: "Object with the `nodes` and `edges` of the fragment, shaped like those of a `bland_conversational_pathway`. A `bland_conversational_pathway` data source can be passed as is.",
+			},
+			function.StringParameter{
+				Name:                "prefix",
+				MarkdownDescription: "Prefix added to every node and edge id of the fragment, e.g. `verify-`.",
+			},
+			function.StringParameter{
+				Name:                "entry",
+				MarkdownDescription: "Id of the node of the fragment the pathway enters the fragment through.",
+			},
+			function.MapParameter{
+				Name:                "exits",
+				MarkdownDescription: "Exits of the fragment, mapping the ids targeted by the fragment that are not part of it to node ids of the pathway.",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func (f *PathwayFragmentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, f.TypeInfo, req)
+	defer exitContext()
+
+	var fragment types.Dynamic
+	var prefix, entry string
+	var exits map[string]string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &fragment, &prefix, &entry, &exits))
+	if resp.Error != nil {
+		return
+	}
+
+	object, ok := fragment.UnderlyingValue().(types.Object)
+	if !ok || object.IsNull() {
+		resp.Error = function.NewArgumentFuncError(0, "fragment must be an object with nodes and edges")
+		return
+	}
+	result, err := NamespacePathwayFragment(ctx, object, prefix, entry, exits)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid pathway fragment: %s", err.Error()))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.DynamicValue(result)))
+}
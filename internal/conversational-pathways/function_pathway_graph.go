@@ -0,0 +1,140 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	utils "github.com/jameshiester/terraform-provider-bland/internal/util"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &PathwayGraphFunction{}
+
+func NewPathwayGraphFunction() function.Function {
+	return &PathwayGraphFunction{
+		TypeInfo: utils.TypeInfo{
+			TypeName: "pathway_graph",
+		},
+	}
+}
+
+func (f *PathwayGraphFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, f.TypeInfo, req)
+	defer exitContext()
+
+	resp.Name = f.TypeName
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.Name))
+}
+
+func (f *PathwayGraphFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	_, exitContext := utils.EnterRequestContext(ctx, f.TypeInfo, req)
+	defer exitContext()
+
+	resp.Definition = function.Definition{
+		Summary: "Renders a conversational pathway as a Mermaid or Graphviz diagram.",
+		MarkdownDescription: "Renders the nodes and edges of a `bland_conversational_pathway` resource or data source as a Mermaid flowchart or Graphviz DOT digraph. " +
+			"Nodes are labelled by name and type, start and global nodes are highlighted and edges are labelled by their label and conditions.",
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:                "pathway",
+				MarkdownDescription: "The pathway to render, usually a `bland_conversational_pathway` resource or data source. Only `name`, `nodes` and `edges` are read.",
+				AttributeTypes:      pathwayGraphFunctionAttributeTypes(),
+			},
+			function.StringParameter{
+				Name:                "format",
+				MarkdownDescription: fmt.Sprintf("Format of the diagram. One of `%s` or `%s`.", PATHWAY_GRAPH_FORMAT_MERMAID, PATHWAY_GRAPH_FORMAT_DOT),
+				Validators: []function.StringParameterValidator{
+					stringvalidator.OneOf(PATHWAY_GRAPH_FORMAT_MERMAID, PATHWAY_GRAPH_FORMAT_DOT),
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *PathwayGraphFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	_, exitContext := utils.EnterRequestContext(ctx, f.TypeInfo, req)
+	defer exitContext()
+
+	var pathway PathwayGraphFunctionModel
+	var format string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &pathway, &format))
+	if resp.Error != nil {
+		return
+	}
+
+	graph, err := RenderPathwayGraph(ConvertFromPathwayGraphFunctionModel(pathway), format)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, graph))
+}
+
+// ConvertFromPathwayGraphFunctionModel converts the function argument into a pathway model holding only what is rendered.
+func ConvertFromPathwayGraphFunctionModel(model PathwayGraphFunctionModel) ConversationalPathwayModel {
+	pathway := ConversationalPathwayModel{Name: model.Name}
+	for _, node := range model.Nodes {
+		nodeModel := ConversationalPathwayNodeModel{ID: node.ID, Type: node.Type}
+		if node.Data != nil {
+			nodeModel.Data.Name = node.Data.Name
+			nodeModel.Data.IsStart = node.Data.IsStart
+			nodeModel.Data.IsGlobal = node.Data.IsGlobal
+		}
+		pathway.Nodes = append(pathway.Nodes, nodeModel)
+	}
+	for _, edge := range model.Edges {
+		edgeModel := ConversationalPathwayEdgeModel{Source: edge.Source, Target: edge.Target}
+		if edge.Data != nil {
+			edgeModel.Data.Label = edge.Data.Label
+			if edge.Data.Conditions != nil {
+				conditions := make([]ConversationalPathwayEdgeConditionModel, 0, len(*edge.Data.Conditions))
+				for _, condition := range *edge.Data.Conditions {
+					conditions = append(conditions, ConversationalPathwayEdgeConditionModel{
+						Field:    condition.Field,
+						Operator: condition.Operator,
+						Value:    condition.Value,
+					})
+				}
+				edgeModel.Data.Conditions = &conditions
+			}
+		}
+		pathway.Edges = append(pathway.Edges, edgeModel)
+	}
+	return pathway
+}
+
+func pathwayGraphFunctionAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name": types.StringType,
+		"nodes": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+			"id":   types.StringType,
+			"type": types.StringType,
+			"data": types.ObjectType{AttrTypes: map[string]attr.Type{
+				"name":      types.StringType,
+				"is_start":  types.BoolType,
+				"is_global": types.BoolType,
+			}},
+		}}},
+		"edges": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+			"source": types.StringType,
+			"target": types.StringType,
+			"data": types.ObjectType{AttrTypes: map[string]attr.Type{
+				"label": types.StringType,
+				"conditions": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+					"field":    types.StringType,
+					"operator": types.StringType,
+					"value":    types.StringType,
+				}}},
+			}},
+		}}},
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testGraphFunctionPathway returns a pathway object as Terraform passes it to the function, after dropping the
+// attributes of the resource the parameter does not declare. Nodes and edges without data are left null.
+func testGraphFunctionPathway() types.Object {
+	attributeTypes := pathwayGraphFunctionAttributeTypes()
+	nodeType := attributeTypes["nodes"].(types.ListType).ElemType.(types.ObjectType)
+	nodeDataType := nodeType.AttrTypes["data"].(types.ObjectType)
+	edgeType := attributeTypes["edges"].(types.ListType).ElemType.(types.ObjectType)
+	edgeDataType := edgeType.AttrTypes["data"].(types.ObjectType)
+	conditionType := edgeDataType.AttrTypes["conditions"].(types.ListType).ElemType.(types.ObjectType)
+
+	start := types.ObjectValueMust(nodeType.AttrTypes, map[string]attr.Value{
+		"id":   types.StringValue("1"),
+		"type": types.StringValue("Default"),
+		"data": types.ObjectValueMust(nodeDataType.AttrTypes, map[string]attr.Value{
+			"name":      types.StringValue("Start"),
+			"is_start":  types.BoolValue(true),
+			"is_global": types.BoolNull(),
+		}),
+	})
+	end := types.ObjectValueMust(nodeType.AttrTypes, map[string]attr.Value{
+		"id":   types.StringValue("2"),
+		"type": types.StringValue("End Call"),
+		"data": types.ObjectValueMust(nodeDataType.AttrTypes, map[string]attr.Value{
+			"name":      types.StringValue("Goodbye"),
+			"is_start":  types.BoolNull(),
+			"is_global": types.BoolNull(),
+		}),
+	})
+	edge := types.ObjectValueMust(edgeType.AttrTypes, map[string]attr.Value{
+		"source": types.StringValue("1"),
+		"target": types.StringValue("2"),
+		"data": types.ObjectValueMust(edgeDataType.AttrTypes, map[string]attr.Value{
+			"label": types.StringValue("done"),
+			"conditions": types.ListValueMust(conditionType, []attr.Value{
+				types.ObjectValueMust(conditionType.AttrTypes, map[string]attr.Value{
+					"field":    types.StringValue("resolved"),
+					"operator": types.StringValue("is"),
+					"value":    types.StringValue("true"),
+				}),
+			}),
+		}),
+	})
+	loop := types.ObjectValueMust(edgeType.AttrTypes, map[string]attr.Value{
+		"source": types.StringValue("2"),
+		"target": types.StringValue("1"),
+		"data":   types.ObjectNull(edgeDataType.AttrTypes),
+	})
+	return types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+		"name":  types.StringValue("Support"),
+		"nodes": types.ListValueMust(nodeType, []attr.Value{start, end}),
+		"edges": types.ListValueMust(edgeType, []attr.Value{edge, loop}),
+	})
+}
+
+func runPathwayGraphFunction(t *testing.T, format string) (string, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{testGraphFunctionPathway(), types.StringValue(format)}),
+	}
+	result, funcErr := function.StringReturn{}.NewResultData(ctx)
+	if funcErr != nil {
+		t.Fatalf("unexpected error: %s", funcErr)
+	}
+	resp := function.RunResponse{Result: result}
+	NewPathwayGraphFunction().Run(ctx, req, &resp)
+	if resp.Error != nil {
+		return "", resp.Error
+	}
+	return resp.Result.Value().(types.String).ValueString(), nil
+}
+
+func TestPathwayGraphFunction_Run(t *testing.T) {
+	expected := map[string]string{
+		PATHWAY_GRAPH_FORMAT_MERMAID: `---
+title: Support
+---
+flowchart TD
+    n_1["Start<br/>Default"]
+    n_2["Goodbye<br/>End Call"]
+    n_1 -->|"done<br/>resolved is true"| n_2
+    n_2 --> n_1
+    classDef start fill:#d4edda,stroke:#28a745,stroke-width:2px
+    class n_1 start
+`,
+		PATHWAY_GRAPH_FORMAT_DOT: `digraph pathway {
+  label="Support";
+  labelloc=t;
+  node [shape=box, style=rounded];
+  "1" [label="Start\nDefault", style="rounded,filled,bold", fillcolor="#d4edda", color="#28a745"];
+  "2" [label="Goodbye\nEnd Call"];
+  "1" -> "2" [label="done\nresolved is true"];
+  "2" -> "1";
+}
+`,
+	}
+	for format, want := range expected {
+		graph, funcErr := runPathwayGraphFunction(t, format)
+		if funcErr != nil {
+			t.Fatalf("unexpected error rendering %s: %s", format, funcErr)
+		}
+		if graph != want {
+			t.Errorf("unexpected %s graph:\n%s", format, graph)
+		}
+	}
+}
+
+func TestPathwayGraphFunction_Run_UnsupportedFormat(t *testing.T) {
+	_, funcErr := runPathwayGraphFunction(t, "svg")
+	if funcErr == nil || funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != 1 {
+		t.Errorf("expected an error on the format argument, got %v", funcErr)
+	}
+}
+
+// assertAttributeTypesCovered checks that every attribute read by the function exists with the same type in a pathway
+// object, so Terraform can convert the object to the parameter by dropping the other attributes.
+func assertAttributeTypesCovered(t *testing.T, path string, want, got attr.Type) {
+	t.Helper()
+	switch want := want.(type) {
+	case types.ObjectType:
+		gotObject, ok := got.(types.ObjectType)
+		if !ok {
+			t.Errorf("%s: expected an object, got %s", path, got)
+			return
+		}
+		for name, attributeType := range want.AttrTypes {
+			gotAttributeType, ok := gotObject.AttrTypes[name]
+			if !ok {
+				t.Errorf("%s.%s: attribute is missing", path, name)
+				continue
+			}
+			assertAttributeTypesCovered(t, path+"."+name, attributeType, gotAttributeType)
+		}
+	case types.ListType:
+		gotList, ok := got.(types.ListType)
+		if !ok {
+			t.Errorf("%s: expected a list, got %s", path, got)
+			return
+		}
+		assertAttributeTypesCovered(t, path+"[]", want.ElemType, gotList.ElemType)
+	default:
+		if !want.Equal(got) {
+			t.Errorf("%s: expected %s, got %s", path, want, got)
+		}
+	}
+}
+
+func TestPathwayGraphFunction_AttributeTypes(t *testing.T) {
+	ctx := context.Background()
+	parameter := types.ObjectType{AttrTypes: pathwayGraphFunctionAttributeTypes()}
+
+	resourceSchema := resource.SchemaResponse{}
+	NewConversationalPathwayResource().Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+	assertAttributeTypesCovered(t, "bland_conversational_pathway", parameter, resourceSchema.Schema.Type())
+
+	dataSourceSchema := datasource.SchemaResponse{}
+	NewConversationalPathwayDataSource().Schema(ctx, datasource.SchemaRequest{}, &dataSourceSchema)
+	assertAttributeTypesCovered(t, "data.bland_conversational_pathway", parameter, dataSourceSchema.Schema.Type())
+}
@@ -14,6 +14,28 @@ type ConversationalPathwayDataSource struct {
 	ApplicationClient client
 }
 
+// PathwayGraphFunction defines the pathway graph function implementation.
+type PathwayGraphFunction struct {
+	utils.TypeInfo
+}
+
+// PathwayFragmentFunction defines the pathway fragment function implementation.
+type PathwayFragmentFunction struct {
+	utils.TypeInfo
+}
+
+// ConversationalPathwaysDataSource defines the pathway list data source implementation.
+type ConversationalPathwaysDataSource struct {
+	utils.TypeInfo
+	ApplicationClient client
+}
+
+// ConversationalPathwayVersionsDataSource defines the pathway versions data source implementation.
+type ConversationalPathwayVersionsDataSource struct {
+	utils.TypeInfo
+	ApplicationClient client
+}
+
 // ConversationalPathwayNodeModel describes the node model.
 type ConversationalPathwayNodeModel struct {
 	Type types.String                       `tfsdk:"type"`
@@ -85,17 +107,22 @@ type ConversationalPathwayNodeDataModel struct {
 	FallbackNodeId   types.String                                        `tfsdk:"fallback_node_id"`
 	TimeoutValue     types.Int64                                         `tfsdk:"timeout_value"`
 	MaxRetries       types.Int64                                         `tfsdk:"max_retries"`
+	ToolID           types.String                                        `tfsdk:"tool_id"`
+	ToolInputs       map[string]types.String                             `tfsdk:"tool_inputs"`
+	Speech           types.String                                        `tfsdk:"speech"`
 }
 
 type ConversationalPathwayAuthModel struct {
-	Type   types.String `tfsdk:"type"`
-	Token  types.String `tfsdk:"token"`
-	Encode types.Bool   `tfsdk:"encode"`
+	Type        types.String `tfsdk:"type"`
+	Token       types.String `tfsdk:"token"`
+	TokenSecret types.String `tfsdk:"token_secret"`
+	Encode      types.Bool   `tfsdk:"encode"`
 }
 
 type ConversationalPathwayHeaderModel struct {
-	Name  types.String `tfsdk:"name"`
-	Value types.String `tfsdk:"value"`
+	Name   types.String `tfsdk:"name"`
+	Value  types.String `tfsdk:"value"`
+	Secret types.String `tfsdk:"secret"`
 }
 
 type ConversationalPathwayExampleModel struct {
@@ -125,18 +152,47 @@ type ConversationalPathwayRouteModel struct {
 	TargetNodeId types.String                               `tfsdk:"target_node_id"`
 }
 
+// ConversationalPathwayModel describes the pathway resource data model.
+type ConversationalPathwayModel struct {
+	Name                types.String                       `tfsdk:"name"`
+	ID                  types.String                       `tfsdk:"id"`
+	Description         types.String                       `tfsdk:"description"`
+	Nodes               []ConversationalPathwayNodeModel   `tfsdk:"nodes"`
+	Edges               []ConversationalPathwayEdgeModel   `tfsdk:"edges"`
+	GlobalConfig        *ConversationalPathwayGlobalConfig `tfsdk:"global_config"`
+	VersionNumber       types.Int64                        `tfsdk:"version_number"`
+	RevisionNumber      types.Int64                        `tfsdk:"revision_number"`
+	ForceOverwrite      types.Bool                         `tfsdk:"force_overwrite"`
+	SourcePathwayID     types.String                       `tfsdk:"source_pathway_id"`
+	SourceVersionNumber types.Int64                        `tfsdk:"source_version_number"`
+	PostCallActions     types.List                         `tfsdk:"post_call_actions"`
+	InputVariables      types.List                         `tfsdk:"input_variables"`
+	FolderID            types.String                       `tfsdk:"folder_id"`
+}
+
 // ConversationalPathwayDataSourceModel describes the data source data model.
 type ConversationalPathwayDataSourceModel struct {
-	Name         types.String                       `tfsdk:"name"`
-	ID           types.String                       `tfsdk:"id"`
-	Description  types.String                       `tfsdk:"description"`
-	Nodes        []ConversationalPathwayNodeModel   `tfsdk:"nodes"`
-	Edges        []ConversationalPathwayEdgeModel   `tfsdk:"edges"`
-	GlobalConfig *ConversationalPathwayGlobalConfig `tfsdk:"global_config"`
+	Name            types.String                       `tfsdk:"name"`
+	ID              types.String                       `tfsdk:"id"`
+	Description     types.String                       `tfsdk:"description"`
+	VersionNumber   types.Int64                        `tfsdk:"version_number"`
+	Environment     types.String                       `tfsdk:"environment"`
+	Nodes           []ConversationalPathwayNodeModel   `tfsdk:"nodes"`
+	Edges           []ConversationalPathwayEdgeModel   `tfsdk:"edges"`
+	GlobalConfig    *ConversationalPathwayGlobalConfig `tfsdk:"global_config"`
+	PostCallActions types.List                         `tfsdk:"post_call_actions"`
+	FolderID        types.String                       `tfsdk:"folder_id"`
+	GraphMermaid    types.String                       `tfsdk:"graph_mermaid"`
+	GraphDot        types.String                       `tfsdk:"graph_dot"`
 }
 
 type ConversationalPathwayGlobalConfig struct {
-	GlobalPrompt types.String `tfsdk:"global_prompt"`
+	GlobalPrompt  types.String                                   `tfsdk:"global_prompt"`
+	ModelOptions  *ConversationalPathwayNodeDataModelOptionModel `tfsdk:"model_options"`
+	Voice         types.String                                   `tfsdk:"voice"`
+	FirstSentence types.String                                   `tfsdk:"first_sentence"`
+	KnowledgeBase types.String                                   `tfsdk:"kb"`
+	KbTool        types.String                                   `tfsdk:"kb_tool"`
 }
 
 type ConversationalPathwayEdgeModel struct {
@@ -161,3 +217,127 @@ type ConversationalPathwayEdgeDataModel struct {
 	AlwaysPick    types.Bool                                 `tfsdk:"always_pick"`
 	Conditions    *[]ConversationalPathwayEdgeConditionModel `tfsdk:"conditions"`
 }
+
+// ConversationalPathwayDeploymentModel describes the deployment resource data model.
+type ConversationalPathwayDeploymentModel struct {
+	ID                    types.String `tfsdk:"id"`
+	PathwayID             types.String `tfsdk:"pathway_id"`
+	Version               types.String `tfsdk:"version"`
+	Environment           types.String `tfsdk:"environment"`
+	DeployedVersionNumber types.Int64  `tfsdk:"deployed_version_number"`
+}
+
+// ConversationalPathwaySummaryModel describes a pathway listed by the pathway list data source.
+type ConversationalPathwaySummaryModel struct {
+	ID                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	Description             types.String `tfsdk:"description"`
+	FolderID                types.String `tfsdk:"folder_id"`
+	FolderName              types.String `tfsdk:"folder_name"`
+	CreatedAt               types.String `tfsdk:"created_at"`
+	UpdatedAt               types.String `tfsdk:"updated_at"`
+	LatestVersionNumber     types.Int64  `tfsdk:"latest_version_number"`
+	StagingVersionNumber    types.Int64  `tfsdk:"staging_version_number"`
+	ProductionVersionNumber types.Int64  `tfsdk:"production_version_number"`
+}
+
+// ConversationalPathwaysDataSourceModel describes the pathway list data source data model.
+type ConversationalPathwaysDataSourceModel struct {
+	NameRegex       types.String                        `tfsdk:"name_regex"`
+	FolderID        types.String                        `tfsdk:"folder_id"`
+	FolderName      types.String                        `tfsdk:"folder_name"`
+	UpdatedAfter    types.String                        `tfsdk:"updated_after"`
+	IncludeVersions types.Bool                          `tfsdk:"include_versions"`
+	IDs             []types.String                      `tfsdk:"ids"`
+	Pathways        []ConversationalPathwaySummaryModel `tfsdk:"pathways"`
+}
+
+// PathwayFolderModel describes the pathway folder resource data model.
+type PathwayFolderModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	ParentFolderID types.String `tfsdk:"parent_folder_id"`
+}
+
+// ConversationalPathwayVersionModel describes a single pathway version.
+type ConversationalPathwayVersionModel struct {
+	VersionNumber       types.Int64  `tfsdk:"version_number"`
+	RevisionNumber      types.Int64  `tfsdk:"revision_number"`
+	Name                types.String `tfsdk:"name"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	SourceVersionNumber types.Int64  `tfsdk:"source_version_number"`
+	IsStaging           types.Bool   `tfsdk:"is_staging"`
+	IsProduction        types.Bool   `tfsdk:"is_production"`
+	IsPublished         types.Bool   `tfsdk:"is_published"`
+}
+
+// ConversationalPathwayVersionsDataSourceModel describes the pathway versions data source data model.
+type ConversationalPathwayVersionsDataSourceModel struct {
+	PathwayID types.String                        `tfsdk:"pathway_id"`
+	Versions  []ConversationalPathwayVersionModel `tfsdk:"versions"`
+}
+
+// PathwayGraphFunctionModel describes the parts of a pathway object read by the pathway graph function.
+type PathwayGraphFunctionModel struct {
+	Name  types.String                    `tfsdk:"name"`
+	Nodes []PathwayGraphFunctionNodeModel `tfsdk:"nodes"`
+	Edges []PathwayGraphFunctionEdgeModel `tfsdk:"edges"`
+}
+
+type PathwayGraphFunctionNodeModel struct {
+	ID   types.String                       `tfsdk:"id"`
+	Type types.String                       `tfsdk:"type"`
+	Data *PathwayGraphFunctionNodeDataModel `tfsdk:"data"`
+}
+
+type PathwayGraphFunctionNodeDataModel struct {
+	Name     types.String `tfsdk:"name"`
+	IsStart  types.Bool   `tfsdk:"is_start"`
+	IsGlobal types.Bool   `tfsdk:"is_global"`
+}
+
+type PathwayGraphFunctionEdgeModel struct {
+	Source types.String                       `tfsdk:"source"`
+	Target types.String                       `tfsdk:"target"`
+	Data   *PathwayGraphFunctionEdgeDataModel `tfsdk:"data"`
+}
+
+type PathwayGraphFunctionEdgeDataModel struct {
+	Label      types.String                              `tfsdk:"label"`
+	Conditions *[]PathwayGraphFunctionEdgeConditionModel `tfsdk:"conditions"`
+}
+
+type PathwayGraphFunctionEdgeConditionModel struct {
+	Field    types.String `tfsdk:"field"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+}
+
+// PathwayTestModel describes the pathway test resource data model.
+type PathwayTestModel struct {
+	ID              types.String            `tfsdk:"id"`
+	PathwayID       types.String            `tfsdk:"pathway_id"`
+	VersionNumber   types.Int64             `tfsdk:"version_number"`
+	StartNodeID     types.String            `tfsdk:"start_node_id"`
+	RequestData     map[string]types.String `tfsdk:"request_data"`
+	Triggers        types.Map               `tfsdk:"triggers"`
+	FailOnAssertion types.Bool              `tfsdk:"fail_on_assertion"`
+	Turns           []PathwayTestTurnModel  `tfsdk:"turns"`
+	Results         types.List              `tfsdk:"results"`
+	Failures        types.List              `tfsdk:"failures"`
+}
+
+type PathwayTestTurnModel struct {
+	Message                types.String            `tfsdk:"message"`
+	ExpectNode             types.String            `tfsdk:"expect_node"`
+	ExpectResponseContains []types.String          `tfsdk:"expect_response_contains"`
+	ExpectVariables        map[string]types.String `tfsdk:"expect_variables"`
+}
+
+type PathwayTestResultModel struct {
+	Message   types.String            `tfsdk:"message"`
+	Responses []types.String          `tfsdk:"responses"`
+	NodeID    types.String            `tfsdk:"node_id"`
+	NodeName  types.String            `tfsdk:"node_name"`
+	Variables map[string]types.String `tfsdk:"variables"`
+}
@@ -0,0 +1,359 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SummarizePathwayChanges returns a human readable list of node, edge and global config changes between two pathways.
+func SummarizePathwayChanges(before, after pathwayDto) []string {
+	changes := make([]string, 0)
+
+	beforeNodes, beforeGlobal := indexPathwayNodes(before.Nodes)
+	afterNodes, afterGlobal := indexPathwayNodes(after.Nodes)
+	for _, id := range sortedKeys(afterNodes) {
+		node := afterNodes[id]
+		previous, ok := beforeNodes[id]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("node %s added", describeNode(node)))
+			continue
+		}
+		if !jsonEqual(previous, node) {
+			changes = append(changes, fmt.Sprintf("node %s changed", describeNode(node)))
+		}
+	}
+	for _, id := range sortedKeys(beforeNodes) {
+		if _, ok := afterNodes[id]; !ok {
+			changes = append(changes, fmt.Sprintf("node %s removed", describeNode(beforeNodes[id])))
+		}
+	}
+	if !jsonEqual(beforeGlobal, afterGlobal) {
+		changes = append(changes, "global config changed")
+	}
+
+	beforeEdges := indexPathwayEdges(before.Edges)
+	afterEdges := indexPathwayEdges(after.Edges)
+	for _, id := range sortedKeys(afterEdges) {
+		edge := afterEdges[id]
+		previous, ok := beforeEdges[id]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("edge %s added", describeEdge(edge)))
+			continue
+		}
+		if !jsonEqual(previous, edge) {
+			changes = append(changes, fmt.Sprintf("edge %s changed", describeEdge(edge)))
+		}
+	}
+	for _, id := range sortedKeys(beforeEdges) {
+		if _, ok := afterEdges[id]; !ok {
+			changes = append(changes, fmt.Sprintf("edge %s removed", describeEdge(beforeEdges[id])))
+		}
+	}
+
+	if before.Name != after.Name {
+		changes = append(changes, fmt.Sprintf("name changed from %q to %q", before.Name, after.Name))
+	}
+	if before.Description != after.Description {
+		changes = append(changes, "description changed")
+	}
+	if !slices.Equal(before.PostCallActions, after.PostCallActions) {
+		changes = append(changes, "post call actions changed")
+	}
+	return changes
+}
+
+func indexPathwayNodes(nodes []pathwayNodeDto) (map[string]pathwayNodeDto, *pathwayGlobalConfigDto) {
+	index := make(map[string]pathwayNodeDto, len(nodes))
+	var globalConfig *pathwayGlobalConfigDto
+	for _, node := range nodes {
+		if node.GlobalConfig != nil {
+			globalConfig = node.GlobalConfig
+			continue
+		}
+		if node.ID != nil {
+			index[*node.ID] = node
+		}
+	}
+	return index, globalConfig
+}
+
+func indexPathwayEdges(edges []pathwayEdgeDto) map[string]pathwayEdgeDto {
+	index := make(map[string]pathwayEdgeDto, len(edges))
+	for _, edge := range edges {
+		index[edge.ID] = edge
+	}
+	return index
+}
+
+func describeNode(node pathwayNodeDto) string {
+	id := ""
+	if node.ID != nil {
+		id = *node.ID
+	}
+	if node.Data != nil && node.Data.Name != "" {
+		return fmt.Sprintf("'%s' (%s)", node.Data.Name, id)
+	}
+	return fmt.Sprintf("'%s'", id)
+}
+
+func describeEdge(edge pathwayEdgeDto) string {
+	if edge.Data.Label != "" {
+		return fmt.Sprintf("'%s' (%s -> %s)", edge.Data.Label, edge.Source, edge.Target)
+	}
+	return fmt.Sprintf("'%s' (%s -> %s)", edge.ID, edge.Source, edge.Target)
+}
+
+func jsonEqual(a, b any) bool {
+	aJson, errA := json.Marshal(a)
+	bJson, errB := json.Marshal(b)
+	if errA != nil || errB != nil {
+		return reflect.DeepEqual(a, b)
+	}
+	return string(aJson) == string(bJson)
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// pathwayTextDiffFields are node data fields whose changes are shown as a short text diff, sensitive fields are only reported as changed.
+var pathwayTextDiffFields = map[string]struct{}{
+	"prompt":        {},
+	"text":          {},
+	"global_prompt": {},
+	"global_label":  {},
+	"condition":     {},
+	"speech":        {},
+	"url":           {},
+}
+
+const pathwayTextDiffContext = 20
+const pathwayTextDiffMaxLength = 60
+
+// DescribePlannedPathwayChanges returns a human readable list of structural changes between the state and the plan of a pathway:
+// nodes added, removed or renamed, node fields changed with a short text diff for prompts and texts, and edges added, removed,
+// retargeted or relabelled.
+func DescribePlannedPathwayChanges(before, after ConversationalPathwayModel) []string {
+	changes := make([]string, 0)
+
+	beforeNodes := indexPathwayNodeModels(before.Nodes)
+	afterNodes := indexPathwayNodeModels(after.Nodes)
+	for _, node := range after.Nodes {
+		id := node.ID.ValueString()
+		previous, ok := beforeNodes[id]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("node %s added", describeNodeModel(after, id)))
+			continue
+		}
+		changes = append(changes, describeNodeChanges(after, previous, node)...)
+	}
+	for _, node := range before.Nodes {
+		if _, ok := afterNodes[node.ID.ValueString()]; !ok {
+			changes = append(changes, fmt.Sprintf("node %s removed", describeNodeModel(before, node.ID.ValueString())))
+		}
+	}
+
+	beforeEdges := indexPathwayEdgeModels(before.Edges)
+	afterEdges := indexPathwayEdgeModels(after.Edges)
+	for _, edge := range after.Edges {
+		previous, ok := beforeEdges[edge.ID.ValueString()]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("edge %s added from %s to %s", describeEdgeModel(edge),
+				describeNodeModel(after, edge.Source.ValueString()), describeNodeModel(after, edge.Target.ValueString())))
+			continue
+		}
+		changes = append(changes, describeEdgeChanges(before, after, previous, edge)...)
+	}
+	for _, edge := range before.Edges {
+		if _, ok := afterEdges[edge.ID.ValueString()]; !ok {
+			changes = append(changes, fmt.Sprintf("edge %s removed", describeEdgeModel(edge)))
+		}
+	}
+
+	if !reflect.DeepEqual(before.GlobalConfig, after.GlobalConfig) {
+		var beforeConfig, afterConfig ConversationalPathwayGlobalConfig
+		if before.GlobalConfig != nil {
+			beforeConfig = *before.GlobalConfig
+		}
+		if after.GlobalConfig != nil {
+			afterConfig = *after.GlobalConfig
+		}
+		for _, change := range describeFieldChanges(beforeConfig, afterConfig) {
+			changes = append(changes, "global config: "+change)
+		}
+	}
+	if !before.Name.Equal(after.Name) {
+		changes = append(changes, fmt.Sprintf("name changed from %q to %q", before.Name.ValueString(), after.Name.ValueString()))
+	}
+	if !before.Description.Equal(after.Description) {
+		changes = append(changes, "description changed")
+	}
+	if !after.InputVariables.IsUnknown() && !slices.Equal(convertListToStrings(before.InputVariables), convertListToStrings(after.InputVariables)) {
+		changes = append(changes, "input variables changed")
+	}
+	if !after.PostCallActions.IsUnknown() && !slices.Equal(convertListToStrings(before.PostCallActions), convertListToStrings(after.PostCallActions)) {
+		changes = append(changes, "post call actions changed")
+	}
+	if !after.FolderID.IsUnknown() && !before.FolderID.Equal(after.FolderID) {
+		changes = append(changes, fmt.Sprintf("moved to folder '%s'", after.FolderID.ValueString()))
+	}
+	return changes
+}
+
+func describeNodeChanges(pathway ConversationalPathwayModel, before, after ConversationalPathwayNodeModel) []string {
+	changes := make([]string, 0)
+	node := describeNodeModel(pathway, after.ID.ValueString())
+	if !before.Data.Name.Equal(after.Data.Name) {
+		changes = append(changes, fmt.Sprintf("node %s renamed from '%s'", node, before.Data.Name.ValueString()))
+	}
+	if !before.Type.Equal(after.Type) {
+		changes = append(changes, fmt.Sprintf("node %s: type changed from %s to %s", node, before.Type.ValueString(), after.Type.ValueString()))
+	}
+	for _, change := range describeFieldChanges(before.Data, after.Data) {
+		changes = append(changes, fmt.Sprintf("node %s: %s", node, change))
+	}
+	return changes
+}
+
+func describeEdgeChanges(beforePathway, afterPathway ConversationalPathwayModel, before, after ConversationalPathwayEdgeModel) []string {
+	changes := make([]string, 0)
+	edge := describeEdgeModel(after)
+	if !before.Source.Equal(after.Source) {
+		changes = append(changes, fmt.Sprintf("edge %s moved from source %s to %s", edge,
+			describeNodeModel(beforePathway, before.Source.ValueString()), describeNodeModel(afterPathway, after.Source.ValueString())))
+	}
+	if !before.Target.Equal(after.Target) {
+		changes = append(changes, fmt.Sprintf("edge %s retargeted from %s to %s", edge,
+			describeNodeModel(beforePathway, before.Target.ValueString()), describeNodeModel(afterPathway, after.Target.ValueString())))
+	}
+	if !before.Data.Label.Equal(after.Data.Label) {
+		changes = append(changes, fmt.Sprintf("edge %s relabelled from '%s'", edge, before.Data.Label.ValueString()))
+	}
+	if !before.Type.Equal(after.Type) {
+		changes = append(changes, fmt.Sprintf("edge %s: type changed from %s to %s", edge, before.Type.ValueString(), after.Type.ValueString()))
+	}
+	for _, change := range describeFieldChanges(before.Data, after.Data) {
+		changes = append(changes, fmt.Sprintf("edge %s: %s", edge, change))
+	}
+	return changes
+}
+
+// describeFieldChanges compares the fields of two structs by their tfsdk tag, skipping the name which is reported as a rename.
+func describeFieldChanges[T any](before, after T) []string {
+	changes := make([]string, 0)
+	beforeValue, afterValue := reflect.ValueOf(before), reflect.ValueOf(after)
+	for i := 0; i < beforeValue.NumField(); i++ {
+		field := beforeValue.Type().Field(i).Tag.Get("tfsdk")
+		if field == "name" || field == "label" {
+			continue
+		}
+		previous, current := beforeValue.Field(i).Interface(), afterValue.Field(i).Interface()
+		if reflect.DeepEqual(previous, current) {
+			continue
+		}
+		previousString, isString := previous.(types.String)
+		currentString, _ := current.(types.String)
+		_, isText := pathwayTextDiffFields[field]
+		switch {
+		case !isString:
+			changes = append(changes, fmt.Sprintf("%s changed", field))
+		case currentString.IsUnknown():
+			changes = append(changes, fmt.Sprintf("%s changed (known after apply)", field))
+		case previousString.IsNull():
+			changes = append(changes, fmt.Sprintf("%s set", field))
+		case currentString.IsNull():
+			changes = append(changes, fmt.Sprintf("%s removed", field))
+		case isText:
+			changes = append(changes, fmt.Sprintf("%s changed: %s", field, shortTextDiff(previousString.ValueString(), currentString.ValueString())))
+		default:
+			changes = append(changes, fmt.Sprintf("%s changed", field))
+		}
+	}
+	return changes
+}
+
+// shortTextDiff returns the changed part of a text in word diff notation, e.g. `"…Say [-hello-]{+hi+} to…"`,
+// with a little surrounding context and long insertions or deletions shortened.
+func shortTextDiff(before, after string) string {
+	a, b := []rune(before), []rune(after)
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	// Widen the change to whole words, which reads better than a diff inside a word.
+	for prefix > 0 && !unicode.IsSpace(a[prefix-1]) {
+		prefix--
+	}
+	for suffix > 0 && !unicode.IsSpace(a[len(a)-suffix]) {
+		suffix--
+	}
+
+	var diff strings.Builder
+	if prefix > pathwayTextDiffContext {
+		diff.WriteString("…" + string(a[prefix-pathwayTextDiffContext:prefix]))
+	} else {
+		diff.WriteString(string(a[:prefix]))
+	}
+	if removed := a[prefix : len(a)-suffix]; len(removed) > 0 {
+		diff.WriteString("[-" + shortenText(removed) + "-]")
+	}
+	if added := b[prefix : len(b)-suffix]; len(added) > 0 {
+		diff.WriteString("{+" + shortenText(added) + "+}")
+	}
+	if suffix > pathwayTextDiffContext {
+		diff.WriteString(string(a[len(a)-suffix:len(a)-suffix+pathwayTextDiffContext]) + "…")
+	} else {
+		diff.WriteString(string(a[len(a)-suffix:]))
+	}
+	return strconv.Quote(diff.String())
+}
+
+func shortenText(text []rune) string {
+	if len(text) > pathwayTextDiffMaxLength {
+		return string(text[:pathwayTextDiffMaxLength]) + "…"
+	}
+	return string(text)
+}
+
+func indexPathwayNodeModels(nodes []ConversationalPathwayNodeModel) map[string]ConversationalPathwayNodeModel {
+	index := make(map[string]ConversationalPathwayNodeModel, len(nodes))
+	for _, node := range nodes {
+		index[node.ID.ValueString()] = node
+	}
+	return index
+}
+
+func indexPathwayEdgeModels(edges []ConversationalPathwayEdgeModel) map[string]ConversationalPathwayEdgeModel {
+	index := make(map[string]ConversationalPathwayEdgeModel, len(edges))
+	for _, edge := range edges {
+		index[edge.ID.ValueString()] = edge
+	}
+	return index
+}
+
+func describeEdgeModel(edge ConversationalPathwayEdgeModel) string {
+	if label := edge.Data.Label.ValueString(); label != "" {
+		return fmt.Sprintf("'%s' (%s)", label, edge.ID.ValueString())
+	}
+	return fmt.Sprintf("'%s'", edge.ID.ValueString())
+}
@@ -0,0 +1,170 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jameshiester/terraform-provider-bland/internal/api"
+	"github.com/jameshiester/terraform-provider-bland/internal/config"
+	"github.com/jarcoal/httpmock"
+)
+
+func strPtr(s string) *string { return &s }
+
+func testPathwayNode(id, name, prompt string) pathwayNodeDto {
+	return pathwayNodeDto{
+		ID:   strPtr(id),
+		Type: strPtr("Default"),
+		Data: &pathwayNodeDataDto{Name: name, Prompt: strPtr(prompt)},
+	}
+}
+
+func TestSummarizePathwayChanges(t *testing.T) {
+	before := pathwayDto{
+		Name: "Pathway",
+		Nodes: []pathwayNodeDto{
+			testPathwayNode("1", "Greeting", "Say hello"),
+			testPathwayNode("2", "Goodbye", "Say goodbye"),
+		},
+		Edges: []pathwayEdgeDto{
+			{ID: "e1", Source: "1", Target: "2", Data: pathwayEdgeDataDto{Label: "done"}},
+		},
+	}
+	after := pathwayDto{
+		Name: "Pathway",
+		Nodes: []pathwayNodeDto{
+			testPathwayNode("1", "Greeting", "Say hi"),
+			testPathwayNode("3", "Transfer", "Transfer the call"),
+			{GlobalConfig: &pathwayGlobalConfigDto{GlobalPrompt: strPtr("Be nice")}},
+		},
+		Edges: []pathwayEdgeDto{
+			{ID: "e1", Source: "1", Target: "3", Data: pathwayEdgeDataDto{Label: "done"}},
+		},
+	}
+
+	changes := SummarizePathwayChanges(before, after)
+	expected := []string{
+		"node 'Greeting' (1) changed",
+		"node 'Transfer' (3) added",
+		"node 'Goodbye' (2) removed",
+		"global config changed",
+		"edge 'done' (1 -> 3) changed",
+	}
+	if len(changes) != len(expected) {
+		t.Fatalf("expected %d changes, got %d: %v", len(expected), len(changes), changes)
+	}
+	for i, e := range expected {
+		if changes[i] != e {
+			t.Errorf("at %d: expected %q, got %q", i, e, changes[i])
+		}
+	}
+
+	if changes := SummarizePathwayChanges(before, before); len(changes) != 0 {
+		t.Errorf("expected no changes, got %v", changes)
+	}
+}
+
+func TestCheckDraftUnchanged(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://api.bland.ai/v1/pathway/abc123",
+		httpmock.NewStringResponder(http.StatusOK, `{"name": "Pathway", "description": "Description", "nodes": [{"id": "1", "type": "Default", "data": {"name": "Greeting", "prompt": "Edited in the UI"}}], "edges": []}`),
+	)
+
+	r := ConversationalPathwayResource{
+		PathwayClient: client{Api: &api.Client{Config: &config.ProviderConfig{BaseURL: "api.bland.ai", APIKey: "123"}}},
+	}
+	state := ConversationalPathwayModel{
+		ID:             types.StringValue("abc123"),
+		Name:           types.StringValue("Pathway"),
+		Description:    types.StringValue("Description"),
+		VersionNumber:  types.Int64Value(2),
+		RevisionNumber: types.Int64Value(3),
+		Nodes: []ConversationalPathwayNodeModel{
+			{
+				ID:   types.StringValue("1"),
+				Type: types.StringValue("Default"),
+				Data: ConversationalPathwayNodeDataModel{
+					Name:   types.StringValue("Greeting"),
+					Prompt: types.StringValue("Say hello"),
+				},
+			},
+		},
+	}
+
+	if err := r.checkDraftUnchanged(context.Background(), state, 2, 3); err != nil {
+		t.Errorf("expected no error for unchanged draft, got %v", err)
+	}
+
+	err := r.checkDraftUnchanged(context.Background(), state, 2, 4)
+	if err == nil {
+		t.Fatalf("expected error for changed draft")
+	}
+	if !strings.Contains(err.Error(), "version 2 revision 3 to version 2 revision 4") {
+		t.Errorf("expected revisions in error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "node 'Greeting' (1) changed") {
+		t.Errorf("expected change summary in error, got %v", err)
+	}
+
+	state.RevisionNumber = types.Int64Null()
+	if err := r.checkDraftUnchanged(context.Background(), state, 2, 4); err != nil {
+		t.Errorf("expected no error without a recorded revision, got %v", err)
+	}
+}
+
+func TestDescribePlannedPathwayChanges(t *testing.T) {
+	greeting := testVariableNode("1", "Greeting", "Say hello to the caller and ask how you can help them today.")
+	goodbye := testVariableNode("2", "Goodbye", "Say goodbye")
+	transfer := testVariableNode("3", "Transfer", "Transfer the call")
+	yes := testVariableEdge("1", "2")
+	yes.ID = types.StringValue("e1")
+	yes.Data.Label = types.StringValue("yes")
+	before := ConversationalPathwayModel{
+		Name:  types.StringValue("Pathway"),
+		Nodes: []ConversationalPathwayNodeModel{greeting, goodbye, transfer},
+		Edges: []ConversationalPathwayEdgeModel{yes},
+	}
+
+	renamed := transfer
+	renamed.Data.Name = types.StringValue("Transfer to agent")
+	edited := greeting
+	edited.Data.Prompt = types.StringValue("Say hi to the caller and ask how you can help them today.")
+	edited.Data.Body = types.StringValue("secret payload")
+	retargeted := yes
+	retargeted.Target = types.StringValue("3")
+	after := ConversationalPathwayModel{
+		Name:  types.StringValue("Pathway"),
+		Nodes: []ConversationalPathwayNodeModel{edited, renamed, testVariableNode("4", "Hold", "Ask the caller to hold")},
+		Edges: []ConversationalPathwayEdgeModel{retargeted},
+	}
+
+	changes := DescribePlannedPathwayChanges(before, after)
+	expected := []string{
+		`node 'Greeting' (1): prompt changed: "Say [-hello-]{+hi+} to the caller and a…"`,
+		"node 'Greeting' (1): body set",
+		"node 'Transfer to agent' (3) renamed from 'Transfer'",
+		"node 'Hold' (4) added",
+		"node 'Goodbye' (2) removed",
+		"edge 'yes' (e1) retargeted from 'Goodbye' (2) to 'Transfer to agent' (3)",
+	}
+	if len(changes) != len(expected) {
+		t.Fatalf("expected %d changes, got %d: %v", len(expected), len(changes), changes)
+	}
+	for i, e := range expected {
+		if changes[i] != e {
+			t.Errorf("at %d: expected %q, got %q", i, e, changes[i])
+		}
+	}
+
+	if changes := DescribePlannedPathwayChanges(before, before); len(changes) != 0 {
+		t.Errorf("expected no changes, got %v", changes)
+	}
+}
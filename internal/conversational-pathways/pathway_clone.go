@@ -0,0 +1,160 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"context"
+
+	arrays "github.com/jameshiester/terraform-provider-bland/internal/util/array"
+)
+
+// getSourcePathway fetches the pathway, or a specific version of it, that a cloned pathway is seeded from.
+func (client *client) getSourcePathway(ctx context.Context, pathway ConversationalPathwayModel) (*pathwayDto, error) {
+	if !pathway.SourceVersionNumber.IsNull() && !pathway.SourceVersionNumber.IsUnknown() {
+		return client.GetPathwayVersion(ctx, pathway.SourcePathwayID.ValueString(), int(pathway.SourceVersionNumber.ValueInt64()))
+	}
+	return client.GetPathway(ctx, pathway.SourcePathwayID.ValueString())
+}
+
+// OverlayPathway applies the nodes, edges and global config of overlay on top of base.
+// Nodes and edges are matched by id, ids listed in removedNodeIDs and removedEdgeIDs are dropped from base
+// along with base edges connected to a removed node.
+// Name and description always come from overlay, post call actions only when set on overlay.
+func OverlayPathway(base, overlay pathwayDto, removedNodeIDs, removedEdgeIDs []string) pathwayDto {
+	result := pathwayDto{
+		ID:              overlay.ID,
+		Name:            overlay.Name,
+		Description:     overlay.Description,
+		PostCallActions: overlay.PostCallActions,
+	}
+	if result.PostCallActions == nil {
+		result.PostCallActions = base.PostCallActions
+	}
+
+	overlayNodes, overlayGlobal := indexPathwayNodes(overlay.Nodes)
+	removedNodes := toSet(removedNodeIDs)
+	var globalNode *pathwayNodeDto
+	for _, node := range base.Nodes {
+		if node.GlobalConfig != nil {
+			baseGlobal := node
+			globalNode = &baseGlobal
+			continue
+		}
+		if node.ID == nil {
+			continue
+		}
+		if _, removed := removedNodes[*node.ID]; removed {
+			continue
+		}
+		if overlayNode, ok := overlayNodes[*node.ID]; ok {
+			result.Nodes = append(result.Nodes, overlayNode)
+			delete(overlayNodes, *node.ID)
+			continue
+		}
+		result.Nodes = append(result.Nodes, node)
+	}
+	for _, node := range overlay.Nodes {
+		if node.ID == nil {
+			continue
+		}
+		if _, ok := overlayNodes[*node.ID]; ok {
+			result.Nodes = append(result.Nodes, node)
+		}
+	}
+	if overlayGlobal != nil {
+		globalNode = &pathwayNodeDto{GlobalConfig: overlayGlobal}
+	}
+	if globalNode != nil {
+		result.Nodes = append(result.Nodes, *globalNode)
+	}
+
+	overlayEdges := indexPathwayEdges(overlay.Edges)
+	removedEdges := toSet(removedEdgeIDs)
+	for _, edge := range base.Edges {
+		if _, removed := removedEdges[edge.ID]; removed {
+			continue
+		}
+		if _, removed := removedNodes[edge.Source]; removed {
+			continue
+		}
+		if _, removed := removedNodes[edge.Target]; removed {
+			continue
+		}
+		if overlayEdge, ok := overlayEdges[edge.ID]; ok {
+			result.Edges = append(result.Edges, overlayEdge)
+			delete(overlayEdges, edge.ID)
+			continue
+		}
+		result.Edges = append(result.Edges, edge)
+	}
+	for _, edge := range overlay.Edges {
+		if _, ok := overlayEdges[edge.ID]; ok {
+			result.Edges = append(result.Edges, edge)
+		}
+	}
+	return result
+}
+
+// FilterManagedPathway keeps only the nodes, edges and global config of pathway that are managed by the given model.
+// It is used for cloned pathways, where nodes inherited from the source are not tracked in state.
+func FilterManagedPathway(pathway pathwayDto, managed ConversationalPathwayModel) pathwayDto {
+	nodeIDs := toSet(managedNodeIDs(managed))
+	edgeIDs := toSet(managedEdgeIDs(managed))
+
+	result := pathwayDto{
+		ID:              pathway.ID,
+		Name:            pathway.Name,
+		Description:     pathway.Description,
+		PostCallActions: pathway.PostCallActions,
+	}
+	for _, node := range pathway.Nodes {
+		if node.GlobalConfig != nil {
+			if managed.GlobalConfig != nil {
+				result.Nodes = append(result.Nodes, node)
+			}
+			continue
+		}
+		if node.ID == nil {
+			continue
+		}
+		if _, ok := nodeIDs[*node.ID]; ok {
+			result.Nodes = append(result.Nodes, node)
+		}
+	}
+	for _, edge := range pathway.Edges {
+		if _, ok := edgeIDs[edge.ID]; ok {
+			result.Edges = append(result.Edges, edge)
+		}
+	}
+	return result
+}
+
+// removedPathwayIDs returns the ids of nodes and edges present in state but no longer in plan.
+func removedPathwayIDs(state, plan ConversationalPathwayModel) (removedNodeIDs, removedEdgeIDs []string) {
+	return arrays.Except(managedNodeIDs(state), managedNodeIDs(plan)), arrays.Except(managedEdgeIDs(state), managedEdgeIDs(plan))
+}
+
+func managedNodeIDs(pathway ConversationalPathwayModel) []string {
+	ids := make([]string, 0, len(pathway.Nodes))
+	for _, node := range pathway.Nodes {
+		ids = append(ids, node.ID.ValueString())
+	}
+	return ids
+}
+
+func managedEdgeIDs(pathway ConversationalPathwayModel) []string {
+	ids := make([]string, 0, len(pathway.Edges))
+	for _, edge := range pathway.Edges {
+		ids = append(ids, edge.ID.ValueString())
+	}
+	return ids
+}
+
+func toSet(items []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		set[item] = struct{}{}
+	}
+	return set
+}
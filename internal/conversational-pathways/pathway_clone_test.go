@@ -0,0 +1,119 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testPathwayNodeIDs(nodes []pathwayNodeDto) []string {
+	ids := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		if node.GlobalConfig != nil {
+			ids = append(ids, "global")
+			continue
+		}
+		ids = append(ids, *node.ID)
+	}
+	return ids
+}
+
+func TestOverlayPathway(t *testing.T) {
+	base := pathwayDto{
+		Name: "Source",
+		Nodes: []pathwayNodeDto{
+			testPathwayNode("1", "Greeting", "Say hello"),
+			testPathwayNode("2", "Goodbye", "Say goodbye"),
+			testPathwayNode("3", "Transfer", "Transfer the call"),
+			{GlobalConfig: &pathwayGlobalConfigDto{GlobalPrompt: strPtr("Be nice")}},
+		},
+		Edges: []pathwayEdgeDto{
+			{ID: "e1", Source: "1", Target: "2"},
+			{ID: "e2", Source: "1", Target: "3"},
+			{ID: "e4", Source: "3", Target: "2"},
+		},
+	}
+	overlay := pathwayDto{
+		Name:        "Clone",
+		Description: "Cloned pathway",
+		Nodes: []pathwayNodeDto{
+			testPathwayNode("1", "Greeting", "Say hi"),
+			testPathwayNode("4", "Survey", "Ask for feedback"),
+		},
+		Edges: []pathwayEdgeDto{
+			{ID: "e3", Source: "1", Target: "4"},
+		},
+	}
+
+	result := OverlayPathway(base, overlay, []string{"3"}, []string{"e2"})
+
+	if result.Name != "Clone" || result.Description != "Cloned pathway" {
+		t.Errorf("expected name and description from overlay, got %q %q", result.Name, result.Description)
+	}
+	expectedNodes := []string{"1", "2", "4", "global"}
+	nodeIDs := testPathwayNodeIDs(result.Nodes)
+	if len(nodeIDs) != len(expectedNodes) {
+		t.Fatalf("expected nodes %v, got %v", expectedNodes, nodeIDs)
+	}
+	for i := range expectedNodes {
+		if nodeIDs[i] != expectedNodes[i] {
+			t.Errorf("expected nodes %v, got %v", expectedNodes, nodeIDs)
+		}
+	}
+	if *result.Nodes[0].Data.Prompt != "Say hi" {
+		t.Errorf("expected overlay node to replace source node, got prompt %q", *result.Nodes[0].Data.Prompt)
+	}
+	if *result.Nodes[3].GlobalConfig.GlobalPrompt != "Be nice" {
+		t.Errorf("expected source global config to be kept, got %q", *result.Nodes[3].GlobalConfig.GlobalPrompt)
+	}
+	if len(result.Edges) != 2 || result.Edges[0].ID != "e1" || result.Edges[1].ID != "e3" {
+		t.Errorf("expected edges e1 and e3, got %v", result.Edges)
+	}
+
+	overlay.Nodes = append(overlay.Nodes, pathwayNodeDto{GlobalConfig: &pathwayGlobalConfigDto{GlobalPrompt: strPtr("Be brief")}})
+	result = OverlayPathway(base, overlay, nil, nil)
+	_, globalConfig := indexPathwayNodes(result.Nodes)
+	if globalConfig == nil || *globalConfig.GlobalPrompt != "Be brief" {
+		t.Errorf("expected overlay global config to replace source global config, got %v", globalConfig)
+	}
+}
+
+func TestFilterManagedPathway(t *testing.T) {
+	pathway := pathwayDto{
+		ID:   "pathway-id",
+		Name: "Clone",
+		Nodes: []pathwayNodeDto{
+			testPathwayNode("1", "Greeting", "Say hi"),
+			testPathwayNode("2", "Goodbye", "Say goodbye"),
+			{GlobalConfig: &pathwayGlobalConfigDto{GlobalPrompt: strPtr("Be nice")}},
+		},
+		Edges: []pathwayEdgeDto{
+			{ID: "e1", Source: "1", Target: "2"},
+		},
+	}
+	managed := ConversationalPathwayModel{
+		Nodes: []ConversationalPathwayNodeModel{{ID: types.StringValue("1")}},
+	}
+
+	result := FilterManagedPathway(pathway, managed)
+
+	if result.ID != "pathway-id" || result.Name != "Clone" {
+		t.Errorf("expected id and name to be kept, got %q %q", result.ID, result.Name)
+	}
+	nodeIDs := testPathwayNodeIDs(result.Nodes)
+	if len(nodeIDs) != 1 || nodeIDs[0] != "1" {
+		t.Errorf("expected only managed node 1, got %v", nodeIDs)
+	}
+	if result.Edges != nil {
+		t.Errorf("expected no managed edges, got %v", result.Edges)
+	}
+
+	managed.GlobalConfig = &ConversationalPathwayGlobalConfig{}
+	result = FilterManagedPathway(pathway, managed)
+	if _, globalConfig := indexPathwayNodes(result.Nodes); globalConfig == nil {
+		t.Error("expected global config to be kept when managed")
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// pathwayFragment rewrites the node and edge ids of a reusable fragment so that it can be concatenated into the nodes and
+// edges of a pathway. Node ids are prefixed and references to exits are bound to nodes of the pathway.
+type pathwayFragment struct {
+	prefix  string
+	nodeIDs map[string]struct{}
+	exits   map[string]string
+}
+
+// NamespacePathwayFragment prefixes the node and edge ids of fragment, an object with `nodes` and `edges` shaped like those of
+// a pathway, and rebinds edges, routes, fallbacks and response pathways targeting an exit to the node the exit is bound to.
+// It returns an object with the rewritten `nodes` and `edges` and the prefixed `entry_node_id`.
+func NamespacePathwayFragment(ctx context.Context, fragment types.Object, prefix, entry string, exits map[string]string) (types.Object, error) {
+	attributes := fragment.Attributes()
+	nodes, ok := attributes["nodes"]
+	if !ok || nodes.IsNull() {
+		return types.ObjectNull(nil), fmt.Errorf("fragment must have nodes")
+	}
+	nodeElements, err := fragmentElements(nodes, "nodes")
+	if err != nil {
+		return types.ObjectNull(nil), err
+	}
+
+	f := &pathwayFragment{prefix: prefix, nodeIDs: make(map[string]struct{}, len(nodeElements)), exits: exits}
+	for i, node := range nodeElements {
+		id, err := fragmentString(node, "id")
+		if err != nil {
+			return types.ObjectNull(nil), fmt.Errorf("nodes[%d]: %w", i, err)
+		}
+		if _, ok := f.nodeIDs[id]; ok {
+			return types.ObjectNull(nil), fmt.Errorf("node id '%s' is used by more than one node of the fragment", id)
+		}
+		f.nodeIDs[id] = struct{}{}
+	}
+	if err := f.checkCollisions(); err != nil {
+		return types.ObjectNull(nil), err
+	}
+	if _, ok := f.nodeIDs[entry]; !ok {
+		return types.ObjectNull(nil), fmt.Errorf("entry node '%s' is not a node of the fragment", entry)
+	}
+
+	for i, node := range nodeElements {
+		if nodeElements[i], err = f.rewriteNode(ctx, node); err != nil {
+			return types.ObjectNull(nil), fmt.Errorf("nodes[%d]: %w", i, err)
+		}
+	}
+	if nodes, err = fragmentWithElements(ctx, nodes, nodeElements); err != nil {
+		return types.ObjectNull(nil), err
+	}
+
+	edges, ok := attributes["edges"]
+	if !ok || edges.IsNull() {
+		edges = types.TupleValueMust([]attr.Type{}, []attr.Value{})
+	}
+	edgeElements, err := fragmentElements(edges, "edges")
+	if err != nil {
+		return types.ObjectNull(nil), err
+	}
+	edgeIDs := make(map[string]struct{}, len(edgeElements))
+	for i, edge := range edgeElements {
+		id, err := fragmentString(edge, "id")
+		if err != nil {
+			return types.ObjectNull(nil), fmt.Errorf("edges[%d]: %w", i, err)
+		}
+		if _, ok := edgeIDs[id]; ok {
+			return types.ObjectNull(nil), fmt.Errorf("edge id '%s' is used by more than one edge of the fragment", id)
+		}
+		edgeIDs[id] = struct{}{}
+		if edgeElements[i], err = f.rewriteEdge(ctx, edge); err != nil {
+			return types.ObjectNull(nil), fmt.Errorf("edges[%d]: %w", i, err)
+		}
+	}
+	if edges, err = fragmentWithElements(ctx, edges, edgeElements); err != nil {
+		return types.ObjectNull(nil), err
+	}
+
+	result, diags := types.ObjectValue(
+		map[string]attr.Type{"nodes": nodes.Type(ctx), "edges": edges.Type(ctx), "entry_node_id": types.StringType},
+		map[string]attr.Value{"nodes": nodes, "edges": edges, "entry_node_id": types.StringValue(prefix + entry)},
+	)
+	return result, diagnosticsError(diags)
+}
+
+// checkCollisions makes sure exits do not shadow fragment nodes and prefixed node ids do not clash with the nodes exits are bound to.
+func (f *pathwayFragment) checkCollisions() error {
+	exitNames := make([]string, 0, len(f.exits))
+	for name := range f.exits {
+		exitNames = append(exitNames, name)
+	}
+	sort.Strings(exitNames)
+	for _, name := range exitNames {
+		if _, ok := f.nodeIDs[name]; ok {
+			return fmt.Errorf("exit '%s' is also the id of a node of the fragment", name)
+		}
+		for id := range f.nodeIDs {
+			if f.prefix+id == f.exits[name] {
+				return fmt.Errorf("node id '%s' of the fragment collides with node '%s' exit '%s' is bound to, use a different prefix", f.prefix+id, f.exits[name], name)
+			}
+		}
+	}
+	return nil
+}
+
+// target returns the id a reference to a node of the fragment or an exit is rewritten to.
+func (f *pathwayFragment) target(id string) (string, error) {
+	if _, ok := f.nodeIDs[id]; ok {
+		return f.prefix + id, nil
+	}
+	if bound, ok := f.exits[id]; ok {
+		return bound, nil
+	}
+	return "", fmt.Errorf("'%s' is neither a node of the fragment nor a bound exit", id)
+}
+
+func (f *pathwayFragment) prefixed(id string) (string, error) {
+	return f.prefix + id, nil
+}
+
+func (f *pathwayFragment) rewriteNode(ctx context.Context, node attr.Value) (attr.Value, error) {
+	node, err := rewriteFragmentAttribute(ctx, node, "id", rewriteFragmentString(f.prefixed))
+	if err != nil {
+		return nil, err
+	}
+	return rewriteFragmentAttribute(ctx, node, "data", func(data attr.Value) (attr.Value, error) {
+		data, err := rewriteFragmentAttribute(ctx, data, "fallback_node_id", rewriteFragmentString(f.target))
+		if err != nil {
+			return nil, err
+		}
+		data, err = rewriteFragmentAttribute(ctx, data, "routes", rewriteFragmentElements(ctx, func(route attr.Value) (attr.Value, error) {
+			return rewriteFragmentAttribute(ctx, route, "target_node_id", rewriteFragmentString(f.target))
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return rewriteFragmentAttribute(ctx, data, "response_pathways", rewriteFragmentElements(ctx, func(responsePathway attr.Value) (attr.Value, error) {
+			return rewriteFragmentAttribute(ctx, responsePathway, "outcome", func(outcome attr.Value) (attr.Value, error) {
+				return rewriteFragmentAttribute(ctx, outcome, "id", rewriteFragmentString(f.target))
+			})
+		}))
+	})
+}
+
+func (f *pathwayFragment) rewriteEdge(ctx context.Context, edge attr.Value) (attr.Value, error) {
+	edge, err := rewriteFragmentAttribute(ctx, edge, "id", rewriteFragmentString(f.prefixed))
+	if err != nil {
+		return nil, err
+	}
+	edge, err = rewriteFragmentAttribute(ctx, edge, "source", rewriteFragmentString(f.target))
+	if err != nil {
+		return nil, err
+	}
+	return rewriteFragmentAttribute(ctx, edge, "target", rewriteFragmentString(f.target))
+}
+
+// fragmentElements returns the elements of a list or tuple, which is what HCL list expressions evaluate to.
+func fragmentElements(value attr.Value, name string) ([]attr.Value, error) {
+	switch v := value.(type) {
+	case types.List:
+		return append([]attr.Value(nil), v.Elements()...), nil
+	case types.Tuple:
+		return append([]attr.Value(nil), v.Elements()...), nil
+	case types.Dynamic:
+		return fragmentElements(v.UnderlyingValue(), name)
+	default:
+		return nil, fmt.Errorf("%s must be a list", name)
+	}
+}
+
+func fragmentWithElements(ctx context.Context, value attr.Value, elements []attr.Value) (attr.Value, error) {
+	switch v := value.(type) {
+	case types.List:
+		list, diags := types.ListValue(v.ElementType(ctx), elements)
+		return list, diagnosticsError(diags)
+	case types.Tuple:
+		tuple, diags := types.TupleValue(v.ElementTypes(ctx), elements)
+		return tuple, diagnosticsError(diags)
+	case types.Dynamic:
+		underlying, err := fragmentWithElements(ctx, v.UnderlyingValue(), elements)
+		return types.DynamicValue(underlying), err
+	default:
+		return nil, fmt.Errorf("unexpected value of type %T", value)
+	}
+}
+
+func fragmentString(value attr.Value, name string) (string, error) {
+	object, ok := value.(types.Object)
+	if !ok {
+		return "", fmt.Errorf("must be an object")
+	}
+	attribute, ok := object.Attributes()[name].(types.String)
+	if !ok || attribute.IsNull() {
+		return "", fmt.Errorf("%s must be a string", name)
+	}
+	return attribute.ValueString(), nil
+}
+
+// rewriteFragmentAttribute rewrites the attribute of an object, leaving objects without the attribute or with a null value as they are.
+func rewriteFragmentAttribute(ctx context.Context, value attr.Value, name string, rewrite func(attr.Value) (attr.Value, error)) (attr.Value, error) {
+	object, ok := value.(types.Object)
+	if !ok || object.IsNull() {
+		return value, nil
+	}
+	attributes := object.Attributes()
+	current, ok := attributes[name]
+	if !ok || current.IsNull() {
+		return value, nil
+	}
+	rewritten, err := rewrite(current)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	attributes[name] = rewritten
+	object, diags := types.ObjectValue(object.AttributeTypes(ctx), attributes)
+	return object, diagnosticsError(diags)
+}
+
+func rewriteFragmentElements(ctx context.Context, rewrite func(attr.Value) (attr.Value, error)) func(attr.Value) (attr.Value, error) {
+	return func(value attr.Value) (attr.Value, error) {
+		elements, err := fragmentElements(value, "value")
+		if err != nil {
+			return nil, err
+		}
+		for i, element := range elements {
+			if elements[i], err = rewrite(element); err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+		}
+		return fragmentWithElements(ctx, value, elements)
+	}
+}
+
+func rewriteFragmentString(rewrite func(string) (string, error)) func(attr.Value) (attr.Value, error) {
+	return func(value attr.Value) (attr.Value, error) {
+		s, ok := value.(types.String)
+		if !ok {
+			return nil, fmt.Errorf("must be a string")
+		}
+		rewritten, err := rewrite(s.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		return types.StringValue(rewritten), nil
+	}
+}
+
+func diagnosticsError(diags diag.Diagnostics) error {
+	for _, d := range diags.Errors() {
+		return fmt.Errorf("%s: %s", d.Summary(), d.Detail())
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testFragmentObject(attributes map[string]attr.Value) types.Object {
+	attributeTypes := make(map[string]attr.Type, len(attributes))
+	for name, value := range attributes {
+		attributeTypes[name] = value.Type(context.Background())
+	}
+	return types.ObjectValueMust(attributeTypes, attributes)
+}
+
+func testFragmentTuple(elements ...attr.Value) types.Tuple {
+	elementTypes := make([]attr.Type, 0, len(elements))
+	for _, element := range elements {
+		elementTypes = append(elementTypes, element.Type(context.Background()))
+	}
+	return types.TupleValueMust(elementTypes, elements)
+}
+
+func testFragment() types.Object {
+	ask := testFragmentObject(map[string]attr.Value{
+		"id":   types.StringValue("ask"),
+		"type": types.StringValue("Default"),
+		"data": testFragmentObject(map[string]attr.Value{
+			"name":             types.StringValue("Ask date of birth"),
+			"fallback_node_id": types.StringValue("failed"),
+			"routes": testFragmentTuple(testFragmentObject(map[string]attr.Value{
+				"target_node_id": types.StringValue("check"),
+			})),
+		}),
+	})
+	check := testFragmentObject(map[string]attr.Value{
+		"id":   types.StringValue("check"),
+		"type": types.StringValue("Webhook"),
+		"data": testFragmentObject(map[string]attr.Value{
+			"name": types.StringValue("Check"),
+			"response_pathways": testFragmentTuple(testFragmentObject(map[string]attr.Value{
+				"outcome": testFragmentObject(map[string]attr.Value{"id": types.StringValue("verified")}),
+			})),
+		}),
+	})
+	edge := testFragmentObject(map[string]attr.Value{
+		"id":     types.StringValue("e1"),
+		"source": types.StringValue("ask"),
+		"target": types.StringValue("check"),
+	})
+	return testFragmentObject(map[string]attr.Value{
+		"nodes": testFragmentTuple(ask, check),
+		"edges": testFragmentTuple(edge),
+	})
+}
+
+func TestNamespacePathwayFragment(t *testing.T) {
+	ctx := context.Background()
+	result, err := NamespacePathwayFragment(ctx, testFragment(), "verify-", "ask", map[string]string{"verified": "5", "failed": "9"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	attributes := result.Attributes()
+	if entry := attributes["entry_node_id"].(types.String).ValueString(); entry != "verify-ask" {
+		t.Errorf("expected entry node verify-ask, got %s", entry)
+	}
+	nodes := attributes["nodes"].(types.Tuple).Elements()
+	ask := nodes[0].(types.Object).Attributes()
+	askData := ask["data"].(types.Object).Attributes()
+	route := askData["routes"].(types.Tuple).Elements()[0].(types.Object).Attributes()
+	check := nodes[1].(types.Object).Attributes()
+	outcome := check["data"].(types.Object).Attributes()["response_pathways"].(types.Tuple).Elements()[0].(types.Object).Attributes()["outcome"].(types.Object).Attributes()
+	edge := attributes["edges"].(types.Tuple).Elements()[0].(types.Object).Attributes()
+
+	for name, test := range map[string]struct {
+		value    attr.Value
+		expected string
+	}{
+		"node id":          {ask["id"], "verify-ask"},
+		"node name":        {askData["name"], "Ask date of birth"},
+		"fallback":         {askData["fallback_node_id"], "9"},
+		"route target":     {route["target_node_id"], "verify-check"},
+		"response outcome": {outcome["id"], "5"},
+		"edge id":          {edge["id"], "verify-e1"},
+		"edge source":      {edge["source"], "verify-ask"},
+		"edge target":      {edge["target"], "verify-check"},
+	} {
+		if value := test.value.(types.String).ValueString(); value != test.expected {
+			t.Errorf("%s: expected %s, got %s", name, test.expected, value)
+		}
+	}
+}
+
+func TestNamespacePathwayFragment_Errors(t *testing.T) {
+	ctx := context.Background()
+	duplicate := testFragmentObject(map[string]attr.Value{
+		"nodes": testFragmentTuple(
+			testFragmentObject(map[string]attr.Value{"id": types.StringValue("1")}),
+			testFragmentObject(map[string]attr.Value{"id": types.StringValue("1")}),
+		),
+	})
+
+	for name, test := range map[string]struct {
+		fragment types.Object
+		prefix   string
+		entry    string
+		exits    map[string]string
+		expected string
+	}{
+		"unbound exit":       {testFragment(), "verify-", "ask", map[string]string{"verified": "5"}, "'failed' is neither a node of the fragment nor a bound exit"},
+		"missing entry":      {testFragment(), "verify-", "start", map[string]string{"verified": "5", "failed": "9"}, "entry node 'start' is not a node of the fragment"},
+		"duplicate node":     {duplicate, "verify-", "1", nil, "node id '1' is used by more than one node"},
+		"exit shadows node":  {testFragment(), "verify-", "ask", map[string]string{"verified": "5", "failed": "9", "check": "3"}, "exit 'check' is also the id of a node"},
+		"prefixed collision": {testFragment(), "", "ask", map[string]string{"verified": "ask", "failed": "9"}, "collides with node 'ask'"},
+	} {
+		_, err := NamespacePathwayFragment(ctx, test.fragment, test.prefix, test.entry, test.exits)
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("%s: expected error containing %q, got %v", name, test.expected, err)
+		}
+	}
+}
+
+func TestValidateUniqueIDs(t *testing.T) {
+	pathway := ConversationalPathwayModel{
+		Nodes: []ConversationalPathwayNodeModel{testVariableNode("1", "A", ""), testVariableNode("2", "B", ""), testVariableNode("1", "C", "")},
+		Edges: []ConversationalPathwayEdgeModel{testVariableEdge("1", "2"), testVariableEdge("1", "2")},
+	}
+	diags := validateUniqueIDs(pathway)
+	if diags.ErrorsCount() != 2 || diags[0].Summary() != "Duplicate node ID" || diags[1].Summary() != "Duplicate edge ID" {
+		t.Errorf("expected duplicate node and edge errors, got %v", diags)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	PATHWAY_GRAPH_FORMAT_MERMAID = "mermaid"
+	PATHWAY_GRAPH_FORMAT_DOT     = "dot"
+)
+
+const (
+	pathwayGraphStartFill  = "#d4edda"
+	pathwayGraphStartLine  = "#28a745"
+	pathwayGraphGlobalFill = "#fff3cd"
+	pathwayGraphGlobalLine = "#ffc107"
+)
+
+var mermaidIDPattern = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// RenderPathwayGraph renders the nodes and edges of the pathway in the given format.
+func RenderPathwayGraph(pathway ConversationalPathwayModel, format string) (string, error) {
+	switch format {
+	case PATHWAY_GRAPH_FORMAT_MERMAID:
+		return RenderPathwayMermaid(pathway), nil
+	case PATHWAY_GRAPH_FORMAT_DOT:
+		return RenderPathwayDot(pathway), nil
+	default:
+		return "", fmt.Errorf("unsupported graph format '%s', must be one of %s or %s", format, PATHWAY_GRAPH_FORMAT_MERMAID, PATHWAY_GRAPH_FORMAT_DOT)
+	}
+}
+
+// RenderPathwayMermaid renders the pathway as a Mermaid flowchart. Nodes are labelled by name and type,
+// start and global nodes are highlighted and edges are labelled by their label and conditions.
+func RenderPathwayMermaid(pathway ConversationalPathwayModel) string {
+	ids := mermaidNodeIDs(pathway)
+	var b strings.Builder
+	if title := pathway.Name.ValueString(); title != "" {
+		fmt.Fprintf(&b, "---\ntitle: %s\n---\n", mermaidText(title))
+	}
+	b.WriteString("flowchart TD\n")
+	for _, node := range pathway.Nodes {
+		id := node.ID.ValueString()
+		fmt.Fprintf(&b, "    %s[\"%s\"]\n", ids[id], strings.Join(mermaidLines(pathwayGraphNodeLabel(node)), "<br/>"))
+	}
+	for _, edge := range pathway.Edges {
+		source, target := mermaidNodeID(ids, edge.Source.ValueString()), mermaidNodeID(ids, edge.Target.ValueString())
+		if label := pathwayGraphEdgeLabel(edge); len(label) > 0 {
+			fmt.Fprintf(&b, "    %s -->|\"%s\"| %s\n", source, strings.Join(mermaidLines(label), "<br/>"), target)
+		} else {
+			fmt.Fprintf(&b, "    %s --> %s\n", source, target)
+		}
+	}
+
+	start, global := make([]string, 0), make([]string, 0)
+	for _, node := range pathway.Nodes {
+		if node.Data.IsStart.ValueBool() {
+			start = append(start, ids[node.ID.ValueString()])
+		} else if node.Data.IsGlobal.ValueBool() {
+			global = append(global, ids[node.ID.ValueString()])
+		}
+	}
+	if len(start) > 0 {
+		fmt.Fprintf(&b, "    classDef start fill:%s,stroke:%s,stroke-width:2px\n", pathwayGraphStartFill, pathwayGraphStartLine)
+		fmt.Fprintf(&b, "    class %s start\n", strings.Join(start, ","))
+	}
+	if len(global) > 0 {
+		fmt.Fprintf(&b, "    classDef global fill:%s,stroke:%s,stroke-dasharray:5 5\n", pathwayGraphGlobalFill, pathwayGraphGlobalLine)
+		fmt.Fprintf(&b, "    class %s global\n", strings.Join(global, ","))
+	}
+	return b.String()
+}
+
+// RenderPathwayDot renders the pathway as a Graphviz digraph with the same labels and highlighting as RenderPathwayMermaid.
+func RenderPathwayDot(pathway ConversationalPathwayModel) string {
+	var b strings.Builder
+	b.WriteString("digraph pathway {\n")
+	if title := pathway.Name.ValueString(); title != "" {
+		fmt.Fprintf(&b, "  label=%s;\n  labelloc=t;\n", dotString([]string{title}))
+	}
+	b.WriteString("  node [shape=box, style=rounded];\n")
+	for _, node := range pathway.Nodes {
+		attributes := []string{"label=" + dotString(pathwayGraphNodeLabel(node))}
+		if node.Data.IsStart.ValueBool() {
+			attributes = append(attributes, `style="rounded,filled,bold"`, fmt.Sprintf(`fillcolor="%s"`, pathwayGraphStartFill), fmt.Sprintf(`color="%s"`, pathwayGraphStartLine))
+		} else if node.Data.IsGlobal.ValueBool() {
+			attributes = append(attributes, `style="rounded,filled,dashed"`, fmt.Sprintf(`fillcolor="%s"`, pathwayGraphGlobalFill), fmt.Sprintf(`color="%s"`, pathwayGraphGlobalLine))
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", dotString([]string{node.ID.ValueString()}), strings.Join(attributes, ", "))
+	}
+	for _, edge := range pathway.Edges {
+		fmt.Fprintf(&b, "  %s -> %s", dotString([]string{edge.Source.ValueString()}), dotString([]string{edge.Target.ValueString()}))
+		if label := pathwayGraphEdgeLabel(edge); len(label) > 0 {
+			fmt.Fprintf(&b, " [label=%s]", dotString(label))
+		}
+		b.WriteString(";\n")
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func pathwayGraphNodeLabel(node ConversationalPathwayNodeModel) []string {
+	name := node.Data.Name.ValueString()
+	if name == "" {
+		name = node.ID.ValueString()
+	}
+	return []string{name, node.Type.ValueString()}
+}
+
+func pathwayGraphEdgeLabel(edge ConversationalPathwayEdgeModel) []string {
+	lines := make([]string, 0)
+	if label := edge.Data.Label.ValueString(); label != "" {
+		lines = append(lines, label)
+	}
+	if edge.Data.Conditions != nil {
+		for _, condition := range *edge.Data.Conditions {
+			parts := make([]string, 0, 3)
+			for _, part := range []string{condition.Field.ValueString(), condition.Operator.ValueString(), condition.Value.ValueString()} {
+				if part != "" {
+					parts = append(parts, part)
+				}
+			}
+			if len(parts) > 0 {
+				lines = append(lines, strings.Join(parts, " "))
+			}
+		}
+	}
+	return lines
+}
+
+// mermaidNodeIDs maps node ids to unique Mermaid identifiers, as node ids may contain characters Mermaid does not accept.
+func mermaidNodeIDs(pathway ConversationalPathwayModel) map[string]string {
+	ids := make(map[string]string, len(pathway.Nodes))
+	used := make(map[string]struct{}, len(pathway.Nodes))
+	for _, node := range pathway.Nodes {
+		id := node.ID.ValueString()
+		if _, ok := ids[id]; ok {
+			continue
+		}
+		candidate := "n_" + mermaidIDPattern.ReplaceAllString(id, "_")
+		for i := 2; ; i++ {
+			if _, ok := used[candidate]; !ok {
+				break
+			}
+			candidate = fmt.Sprintf("n_%s_%d", mermaidIDPattern.ReplaceAllString(id, "_"), i)
+		}
+		ids[id] = candidate
+		used[candidate] = struct{}{}
+	}
+	return ids
+}
+
+// mermaidNodeID returns the identifier of the node, or one derived from the id for edges pointing at nodes that are not part of the pathway.
+func mermaidNodeID(ids map[string]string, id string) string {
+	if mermaidID, ok := ids[id]; ok {
+		return mermaidID
+	}
+	return "n_" + mermaidIDPattern.ReplaceAllString(id, "_")
+}
+
+func mermaidLines(lines []string) []string {
+	escaped := make([]string, 0, len(lines))
+	for _, line := range lines {
+		escaped = append(escaped, mermaidText(line))
+	}
+	return escaped
+}
+
+func mermaidText(value string) string {
+	return strings.NewReplacer(`"`, "#quot;", "\n", " ", "<", "#lt;", ">", "#gt;").Replace(value)
+}
+
+func dotString(lines []string) string {
+	escaped := make([]string, 0, len(lines))
+	for _, line := range lines {
+		escaped = append(escaped, strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(line))
+	}
+	return `"` + strings.Join(escaped, `\n`) + `"`
+}
@@ -0,0 +1,73 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testGraphPathway() ConversationalPathwayModel {
+	start := testVariableNode("1", "Start", "")
+	start.Data.IsStart = types.BoolValue(true)
+	lookup := testVariableNode("node-2", "Look \"up\"", "")
+	lookup.Type = types.StringValue("Webhook")
+	help := testVariableNode("3", "Help", "")
+	help.Data.IsGlobal = types.BoolValue(true)
+
+	edge := testVariableEdge("1", "node-2")
+	edge.Data.Label = types.StringValue("order number provided")
+	edge.Data.Conditions = &[]ConversationalPathwayEdgeConditionModel{
+		{Field: types.StringValue("order_id"), Operator: types.StringValue("is not empty")},
+	}
+	return ConversationalPathwayModel{
+		Name:  types.StringValue("Orders"),
+		Nodes: []ConversationalPathwayNodeModel{start, lookup, help},
+		Edges: []ConversationalPathwayEdgeModel{edge, testVariableEdge("node-2", "3")},
+	}
+}
+
+func TestRenderPathwayMermaid(t *testing.T) {
+	expected := `---
+title: Orders
+---
+flowchart TD
+    n_1["Start<br/>Default"]
+    n_node_2["Look #quot;up#quot;<br/>Webhook"]
+    n_3["Help<br/>Default"]
+    n_1 -->|"order number provided<br/>order_id is not empty"| n_node_2
+    n_node_2 --> n_3
+    classDef start fill:#d4edda,stroke:#28a745,stroke-width:2px
+    class n_1 start
+    classDef global fill:#fff3cd,stroke:#ffc107,stroke-dasharray:5 5
+    class n_3 global
+`
+	if graph := RenderPathwayMermaid(testGraphPathway()); graph != expected {
+		t.Errorf("unexpected mermaid graph:\n%s", graph)
+	}
+}
+
+func TestRenderPathwayDot(t *testing.T) {
+	expected := `digraph pathway {
+  label="Orders";
+  labelloc=t;
+  node [shape=box, style=rounded];
+  "1" [label="Start\nDefault", style="rounded,filled,bold", fillcolor="#d4edda", color="#28a745"];
+  "node-2" [label="Look \"up\"\nWebhook"];
+  "3" [label="Help\nDefault", style="rounded,filled,dashed", fillcolor="#fff3cd", color="#ffc107"];
+  "1" -> "node-2" [label="order number provided\norder_id is not empty"];
+  "node-2" -> "3";
+}
+`
+	if graph := RenderPathwayDot(testGraphPathway()); graph != expected {
+		t.Errorf("unexpected dot graph:\n%s", graph)
+	}
+}
+
+func TestRenderPathwayGraph_UnsupportedFormat(t *testing.T) {
+	if _, err := RenderPathwayGraph(testGraphPathway(), "svg"); err == nil {
+		t.Error("expected an error for an unsupported format")
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jameshiester/terraform-provider-bland/internal/secret"
+)
+
+// SecretReference is a bland_secret referenced by ID or name from a pathway node.
+type SecretReference struct {
+	Path   path.Path
+	NodeID string
+	Ref    string
+}
+
+// FindSecretReferences returns the secrets referenced through token_secret, header secrets and `{{secrets.<name>}}` placeholders.
+func FindSecretReferences(pathway ConversationalPathwayModel) []SecretReference {
+	references := make([]SecretReference, 0)
+	add := func(attributePath path.Path, nodeID string, value types.String) {
+		if value.IsNull() || value.IsUnknown() {
+			return
+		}
+		references = append(references, SecretReference{Path: attributePath, NodeID: nodeID, Ref: value.ValueString()})
+	}
+	addPlaceholders := func(attributePath path.Path, nodeID string, value types.String) {
+		if value.IsNull() || value.IsUnknown() {
+			return
+		}
+		for _, match := range secret.PlaceholderPattern.FindAllStringSubmatch(value.ValueString(), -1) {
+			references = append(references, SecretReference{Path: attributePath, NodeID: nodeID, Ref: match[1]})
+		}
+	}
+
+	for i, node := range pathway.Nodes {
+		nodeID := node.ID.ValueString()
+		dataPath := path.Root("nodes").AtListIndex(i).AtName("data")
+		if node.Data.Auth != nil {
+			add(dataPath.AtName("auth").AtName("token_secret"), nodeID, node.Data.Auth.TokenSecret)
+			addPlaceholders(dataPath.AtName("auth").AtName("token"), nodeID, node.Data.Auth.Token)
+		}
+		for j, header := range node.Data.Headers {
+			add(dataPath.AtName("headers").AtListIndex(j).AtName("secret"), nodeID, header.Secret)
+			addPlaceholders(dataPath.AtName("headers").AtListIndex(j).AtName("value"), nodeID, header.Value)
+		}
+		addPlaceholders(dataPath.AtName("prompt"), nodeID, node.Data.Prompt)
+		addPlaceholders(dataPath.AtName("text"), nodeID, node.Data.Text)
+		addPlaceholders(dataPath.AtName("body"), nodeID, node.Data.Body)
+		addPlaceholders(dataPath.AtName("url"), nodeID, node.Data.URL)
+		for _, name := range sortedKeys(node.Data.ToolInputs) {
+			addPlaceholders(dataPath.AtName("tool_inputs").AtMapKey(name), nodeID, node.Data.ToolInputs[name])
+		}
+	}
+	return references
+}
+
+// secretResolver resolves secret IDs and names to secret names, listing the secrets of the account at most once.
+type secretResolver struct {
+	client *secret.SecretClient
+	names  map[string]string
+}
+
+func newSecretResolver(client *secret.SecretClient) *secretResolver {
+	return &secretResolver{client: client}
+}
+
+func (s *secretResolver) resolve(ctx context.Context, ref string) (string, bool, error) {
+	if s.names == nil {
+		secrets, err := s.client.ListSecrets(ctx)
+		if err != nil {
+			return "", false, err
+		}
+		s.names = make(map[string]string, 2*len(secrets))
+		for _, item := range secrets {
+			s.names[item.ID] = item.Name
+			s.names[item.Name] = item.Name
+		}
+	}
+	name, ok := s.names[ref]
+	return name, ok, nil
+}
+
+// renderSecretReferences replaces the placeholders rendered for secrets referenced by ID with the name of the secret.
+// dto must be converted from pathway, so that the nodes of both are in the same order.
+func renderSecretReferences(ctx context.Context, dto *pathwayDto, pathway ConversationalPathwayModel, resolver *secretResolver) error {
+	render := func(ref types.String) (string, error) {
+		name, ok, err := resolver.resolve(ctx, ref.ValueString())
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			return "", fmt.Errorf("secret '%s' not found", ref.ValueString())
+		}
+		return secret.Placeholder(name), nil
+	}
+
+	for i, node := range pathway.Nodes {
+		data := dto.Nodes[i].Data
+		if data == nil {
+			continue
+		}
+		if node.Data.Auth != nil && !node.Data.Auth.TokenSecret.IsNull() && data.Auth != nil {
+			token, err := render(node.Data.Auth.TokenSecret)
+			if err != nil {
+				return err
+			}
+			data.Auth.Token = token
+		}
+		for j, header := range node.Data.Headers {
+			if header.Secret.IsNull() || data.Headers == nil {
+				continue
+			}
+			value, err := render(header.Secret)
+			if err != nil {
+				return err
+			}
+			(*data.Headers)[j][1] = value
+		}
+	}
+	return nil
+}
+
+// restoreSecretReferences keeps the secret references and placeholders of prior where Bland returned a placeholder for the same secret,
+// so that referencing a secret by ID or writing the placeholder by hand does not show up as drift.
+func restoreSecretReferences(ctx context.Context, model *ConversationalPathwayModel, prior ConversationalPathwayModel, resolver *secretResolver) error {
+	priorNodes := make(map[string]ConversationalPathwayNodeModel, len(prior.Nodes))
+	for _, node := range prior.Nodes {
+		priorNodes[node.ID.ValueString()] = node
+	}
+
+	restore := func(value, secretRef *types.String, priorValue, priorSecretRef types.String) error {
+		if secretRef.IsNull() {
+			return nil
+		}
+		name := secretRef.ValueString()
+		if priorName, ok := secret.PlaceholderName(priorValue.ValueString()); ok && priorName == name {
+			*value, *secretRef = priorValue, types.StringNull()
+			return nil
+		}
+		if priorSecretRef.IsNull() || priorSecretRef.IsUnknown() || priorSecretRef.ValueString() == name {
+			return nil
+		}
+		resolved, ok, err := resolver.resolve(ctx, priorSecretRef.ValueString())
+		if err != nil {
+			return err
+		}
+		if ok && resolved == name {
+			*secretRef = priorSecretRef
+		}
+		return nil
+	}
+
+	for i := range model.Nodes {
+		data := &model.Nodes[i].Data
+		priorNode, ok := priorNodes[model.Nodes[i].ID.ValueString()]
+		if !ok {
+			continue
+		}
+		if data.Auth != nil && priorNode.Data.Auth != nil {
+			err := restore(&data.Auth.Token, &data.Auth.TokenSecret, priorNode.Data.Auth.Token, priorNode.Data.Auth.TokenSecret)
+			if err != nil {
+				return err
+			}
+		}
+		for j := range data.Headers {
+			if j >= len(priorNode.Data.Headers) {
+				break
+			}
+			priorHeader := priorNode.Data.Headers[j]
+			err := restore(&data.Headers[j].Value, &data.Headers[j].Secret, priorHeader.Value, priorHeader.Secret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jameshiester/terraform-provider-bland/internal/api"
+	"github.com/jameshiester/terraform-provider-bland/internal/config"
+	"github.com/jameshiester/terraform-provider-bland/internal/secret"
+	"github.com/jarcoal/httpmock"
+)
+
+const testSecretsResponse = `{"data": [{"id": "SEC-1", "name": "crm_token", "static": true}, {"id": "SEC-2", "name": "crm_key", "static": true}]}`
+
+func testSecretResolver() *secretResolver {
+	return newSecretResolver(&secret.SecretClient{Api: &api.Client{Config: &config.ProviderConfig{BaseURL: "api.bland.ai", APIKey: "123"}}})
+}
+
+func testWebhookPathway() ConversationalPathwayModel {
+	return ConversationalPathwayModel{
+		Nodes: []ConversationalPathwayNodeModel{
+			{
+				ID:   types.StringValue("1"),
+				Type: types.StringValue("Webhook"),
+				Data: ConversationalPathwayNodeDataModel{
+					Name: types.StringValue("Sync"),
+					Body: types.StringValue(`{"key": "{{secrets.crm_key}}", "missing": "{{secrets.unknown}}"}`),
+					Auth: &ConversationalPathwayAuthModel{
+						Type:        types.StringValue("Bearer"),
+						TokenSecret: types.StringValue("SEC-1"),
+					},
+					Headers: []ConversationalPathwayHeaderModel{
+						{Name: types.StringValue("X-Api-Key"), Secret: types.StringValue("crm_key")},
+						{Name: types.StringValue("X-Plain"), Value: types.StringValue("{{secrets.crm_token}}")},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestFindSecretReferences(t *testing.T) {
+	references := FindSecretReferences(testWebhookPathway())
+	dataPath := path.Root("nodes").AtListIndex(0).AtName("data")
+	expected := []SecretReference{
+		{Path: dataPath.AtName("auth").AtName("token_secret"), NodeID: "1", Ref: "SEC-1"},
+		{Path: dataPath.AtName("headers").AtListIndex(0).AtName("secret"), NodeID: "1", Ref: "crm_key"},
+		{Path: dataPath.AtName("headers").AtListIndex(1).AtName("value"), NodeID: "1", Ref: "crm_token"},
+		{Path: dataPath.AtName("body"), NodeID: "1", Ref: "crm_key"},
+		{Path: dataPath.AtName("body"), NodeID: "1", Ref: "unknown"},
+	}
+	if len(references) != len(expected) {
+		t.Fatalf("expected %d references, got %d: %v", len(expected), len(references), references)
+	}
+	for i, reference := range references {
+		if reference.Ref != expected[i].Ref || !reference.Path.Equal(expected[i].Path) {
+			t.Errorf("expected %s at %s, got %s at %s", expected[i].Ref, expected[i].Path, reference.Ref, reference.Path)
+		}
+	}
+}
+
+func TestRenderAndRestoreSecretReferences(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://api.bland.ai/v1/secrets",
+		httpmock.NewStringResponder(http.StatusOK, testSecretsResponse))
+
+	pathway := testWebhookPathway()
+	resolver := testSecretResolver()
+	dto := ConvertFromPathwayModel(pathway)
+	if err := renderSecretReferences(context.Background(), &dto, pathway, resolver); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data := dto.Nodes[0].Data
+	if data.Auth.Token != "{{secrets.crm_token}}" {
+		t.Errorf("expected token to reference the secret by name, got %q", data.Auth.Token)
+	}
+	if value := (*data.Headers)[0][1]; value != "{{secrets.crm_key}}" {
+		t.Errorf("expected header to reference the secret, got %q", value)
+	}
+	if value := (*data.Headers)[1][1]; value != "{{secrets.crm_token}}" {
+		t.Errorf("expected plain header value to be sent as is, got %q", value)
+	}
+
+	model, err := ConvertFromPathwayDto(dto)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := restoreSecretReferences(context.Background(), model, pathway, resolver); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	auth := model.Nodes[0].Data.Auth
+	if auth.TokenSecret.ValueString() != "SEC-1" || !auth.Token.IsNull() {
+		t.Errorf("expected token secret reference by ID to be kept, got %v %v", auth.TokenSecret, auth.Token)
+	}
+	headers := model.Nodes[0].Data.Headers
+	if headers[0].Secret.ValueString() != "crm_key" || !headers[0].Value.IsNull() {
+		t.Errorf("expected header secret reference to be kept, got %v %v", headers[0].Secret, headers[0].Value)
+	}
+	if headers[1].Value.ValueString() != "{{secrets.crm_token}}" || !headers[1].Secret.IsNull() {
+		t.Errorf("expected placeholder header value to be kept, got %v %v", headers[1].Value, headers[1].Secret)
+	}
+	if info := httpmock.GetCallCountInfo(); info["GET https://api.bland.ai/v1/secrets"] != 1 {
+		t.Errorf("expected secrets to be listed once, got %v", info)
+	}
+}
+
+func TestValidateSecretReferences(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://api.bland.ai/v1/secrets",
+		httpmock.NewStringResponder(http.StatusOK, testSecretsResponse))
+
+	r := &ConversationalPathwayResource{SecretClient: testSecretResolver().client}
+	diags := r.validateSecretReferences(context.Background(), testWebhookPathway())
+	if diags.ErrorsCount() != 1 || diags[0].Summary() != "Secret not found" {
+		t.Fatalf("expected one secret not found error, got %v", diags)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CheckPathwayTestTurn returns the assertions of a scripted turn that the chat response does not satisfy.
+// expect_node matches the id or the name of the node the chat is at after the turn, expect_response_contains
+// matches any of the responses of the agent and expect_variables the variables extracted so far.
+func CheckPathwayTestTurn(index int, turn PathwayTestTurnModel, chat pathwayChatDto) []string {
+	failures := make([]string, 0)
+	prefix := fmt.Sprintf("turn %d (%q)", index+1, turn.Message.ValueString())
+
+	if expected := turn.ExpectNode.ValueString(); !turn.ExpectNode.IsNull() && expected != chat.CurrentNodeID && expected != chat.CurrentNodeName {
+		failures = append(failures, fmt.Sprintf("%s: expected node '%s', got %s", prefix, expected, describeChatNode(chat)))
+	}
+
+	responses := strings.Join(chat.AssistantResponses, "\n")
+	for _, expected := range turn.ExpectResponseContains {
+		if !strings.Contains(responses, expected.ValueString()) {
+			failures = append(failures, fmt.Sprintf("%s: expected the response to contain %q, got %q", prefix, expected.ValueString(), responses))
+		}
+	}
+
+	for _, name := range sortedKeys(turn.ExpectVariables) {
+		expected := turn.ExpectVariables[name].ValueString()
+		value, ok := chat.Variables[name]
+		if !ok {
+			failures = append(failures, fmt.Sprintf("%s: expected variable '%s' to be %q, but it was not extracted", prefix, name, expected))
+			continue
+		}
+		if actual := formatChatVariable(value); actual != expected {
+			failures = append(failures, fmt.Sprintf("%s: expected variable '%s' to be %q, got %q", prefix, name, expected, actual))
+		}
+	}
+	return failures
+}
+
+// ConvertFromPathwayChatDto converts the response to a scripted turn into its result.
+func ConvertFromPathwayChatDto(message string, chat pathwayChatDto) PathwayTestResultModel {
+	result := PathwayTestResultModel{
+		Message:   types.StringValue(message),
+		Responses: make([]types.String, 0, len(chat.AssistantResponses)),
+		NodeID:    types.StringValue(chat.CurrentNodeID),
+		NodeName:  types.StringValue(chat.CurrentNodeName),
+		Variables: make(map[string]types.String, len(chat.Variables)),
+	}
+	for _, response := range chat.AssistantResponses {
+		result.Responses = append(result.Responses, types.StringValue(response))
+	}
+	names := make([]string, 0, len(chat.Variables))
+	for name := range chat.Variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		result.Variables[name] = types.StringValue(formatChatVariable(chat.Variables[name]))
+	}
+	return result
+}
+
+// formatChatVariable formats an extracted variable the way it is written in expect_variables, JSON encoding anything but strings.
+func formatChatVariable(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(encoded)
+	}
+}
+
+func describeChatNode(chat pathwayChatDto) string {
+	if chat.CurrentNodeName != "" {
+		return fmt.Sprintf("'%s' (%s)", chat.CurrentNodeName, chat.CurrentNodeID)
+	}
+	return fmt.Sprintf("'%s'", chat.CurrentNodeID)
+}
+
+func pathwayTestResultAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"message":   types.StringType,
+		"responses": types.ListType{ElemType: types.StringType},
+		"node_id":   types.StringType,
+		"node_name": types.StringType,
+		"variables": types.MapType{ElemType: types.StringType},
+	}
+}
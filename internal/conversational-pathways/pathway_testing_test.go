@@ -0,0 +1,128 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jameshiester/terraform-provider-bland/internal/api"
+	"github.com/jameshiester/terraform-provider-bland/internal/config"
+	"github.com/jarcoal/httpmock"
+)
+
+// fakePathwayChat is a local fake of the pathway chat endpoint: the start node asks for the order number,
+// any message containing a number moves the chat to the lookup node and extracts it as order_id.
+func fakePathwayChat(t *testing.T) *createPathwayChatDto {
+	created := &createPathwayChatDto{}
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/v1/pathway/chat/create",
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(created); err != nil {
+				t.Fatalf("unexpected chat request: %v", err)
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{"data": {"chat_id": "chat-1"}, "errors": null}`), nil
+		})
+
+	variables := map[string]any{}
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/v1/pathway/chat/chat-1",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			var message pathwayChatMessageDto
+			_ = json.Unmarshal(body, &message)
+
+			chat := pathwayChatDto{ChatID: "chat-1", CurrentNodeID: "1", CurrentNodeName: "Start", AssistantResponses: []string{"What is your order number?"}}
+			if fields := strings.Fields(message.Message); len(fields) > 0 && strings.Trim(fields[len(fields)-1], "0123456789") == "" {
+				variables["order_id"] = json.Number(fields[len(fields)-1])
+				chat.CurrentNodeID, chat.CurrentNodeName = "2", "Lookup Order"
+				chat.AssistantResponses = []string{"One moment while I look that up."}
+			}
+			chat.Variables = variables
+			return httpmock.NewJsonResponse(http.StatusOK, pathwayChatResponseDto{Data: &chat})
+		})
+	return created
+}
+
+func TestPathwayTestRun(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	created := fakePathwayChat(t)
+	r := PathwayTestResource{
+		PathwayClient: client{Api: &api.Client{Config: &config.ProviderConfig{BaseURL: "api.bland.ai", APIKey: "123"}}},
+	}
+	plan := PathwayTestModel{
+		PathwayID:     types.StringValue("abc123"),
+		VersionNumber: types.Int64Value(3),
+		StartNodeID:   types.StringNull(),
+		Turns: []PathwayTestTurnModel{
+			{
+				Message:                types.StringValue("Hi"),
+				ExpectNode:             types.StringValue("Start"),
+				ExpectResponseContains: []types.String{types.StringValue("order number")},
+			},
+			{
+				Message:         types.StringValue("It is 4521"),
+				ExpectNode:      types.StringValue("Transfer"),
+				ExpectVariables: map[string]types.String{"order_id": types.StringValue("4521"), "email": types.StringValue("jane@example.com")},
+			},
+		},
+	}
+
+	chatID, results, failures, err := r.run(context.Background(), plan)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if chatID != "chat-1" || created.PathwayID != "abc123" || created.VersionNumber == nil || *created.VersionNumber != 3 {
+		t.Errorf("unexpected chat %s created with %+v", chatID, created)
+	}
+	if len(results) != 2 || results[1].NodeName.ValueString() != "Lookup Order" || results[1].Variables["order_id"].ValueString() != "4521" {
+		t.Errorf("unexpected results %+v", results)
+	}
+	expected := []string{
+		`turn 2 ("It is 4521"): expected node 'Transfer', got 'Lookup Order' (2)`,
+		`turn 2 ("It is 4521"): expected variable 'email' to be "jane@example.com", but it was not extracted`,
+	}
+	if len(failures) != len(expected) {
+		t.Fatalf("expected %d failures, got %d: %v", len(expected), len(failures), failures)
+	}
+	for i, e := range expected {
+		if failures[i] != e {
+			t.Errorf("at %d: expected %q, got %q", i, e, failures[i])
+		}
+	}
+}
+
+func TestCheckPathwayTestTurn(t *testing.T) {
+	chat := pathwayChatDto{
+		CurrentNodeID:      "2",
+		CurrentNodeName:    "Lookup Order",
+		AssistantResponses: []string{"Your order has shipped."},
+		Variables:          map[string]any{"order_id": float64(4521), "express": true},
+	}
+	turn := PathwayTestTurnModel{
+		Message:                types.StringValue("Where is my order?"),
+		ExpectNode:             types.StringValue("2"),
+		ExpectResponseContains: []types.String{types.StringValue("shipped"), types.StringValue("delivered")},
+		ExpectVariables:        map[string]types.String{"order_id": types.StringValue("4521"), "express": types.StringValue("false")},
+	}
+
+	failures := CheckPathwayTestTurn(0, turn, chat)
+	expected := []string{
+		`turn 1 ("Where is my order?"): expected the response to contain "delivered", got "Your order has shipped."`,
+		`turn 1 ("Where is my order?"): expected variable 'express' to be "false", got "true"`,
+	}
+	if len(failures) != len(expected) {
+		t.Fatalf("expected %d failures, got %d: %v", len(expected), len(failures), failures)
+	}
+	for i, e := range expected {
+		if failures[i] != e {
+			t.Errorf("at %d: expected %q, got %q", i, e, failures[i])
+		}
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var pathwayVariablePattern = regexp.MustCompile(`\{\{\s*([^{}]+?)\s*\}\}`)
+
+// builtinPathwayVariables are available on every call without being extracted or declared.
+var builtinPathwayVariables = []string{
+	"call_id",
+	"city",
+	"country",
+	"from",
+	"lastUserMessage",
+	"now",
+	"now_utc",
+	"phone_number",
+	"short_from",
+	"short_to",
+	"state",
+	"to",
+	"zip",
+}
+
+// VariableReference is a `{{variable}}` placeholder that no upstream node or declared input provides.
+type VariableReference struct {
+	Path     path.Path
+	NodeID   string
+	Variable string
+	// Route is the shortest chain of nodes from a start node to the referencing node, empty when unreachable.
+	Route []string
+}
+
+// FindUndefinedVariableReferences returns the placeholders in node prompts, texts, bodies, urls, header values and tool
+// inputs that are not produced by extract_vars or response_data of the node itself, a node upstream of it or a global node,
+// and are neither declared in input_variables nor provided by Bland. Pathways cloned from source_pathway_id are not
+// checked, the nodes inherited from the source are not in the configuration and may produce any variable.
+func FindUndefinedVariableReferences(pathway ConversationalPathwayModel) []VariableReference {
+	if !pathway.SourcePathwayID.IsNull() {
+		return nil
+	}
+	available := make(map[string]struct{})
+	for _, name := range builtinPathwayVariables {
+		available[name] = struct{}{}
+	}
+	if !pathway.InputVariables.IsNull() && !pathway.InputVariables.IsUnknown() {
+		for _, name := range convertListToStrings(pathway.InputVariables) {
+			available[name] = struct{}{}
+		}
+	}
+
+	produced := make(map[string][]string, len(pathway.Nodes))
+	for _, node := range pathway.Nodes {
+		vars := producedVariables(node.Data)
+		produced[node.ID.ValueString()] = vars
+		if node.Data.IsGlobal.ValueBool() {
+			// Global nodes can be entered from anywhere in the conversation.
+			for _, name := range vars {
+				available[name] = struct{}{}
+			}
+		}
+	}
+
+	predecessors, successors := pathwayGraph(pathway)
+	references := make([]VariableReference, 0)
+	for i, node := range pathway.Nodes {
+		nodeID := node.ID.ValueString()
+		var nodeAvailable map[string]struct{}
+		for _, field := range variableFields(path.Root("nodes").AtListIndex(i).AtName("data"), node.Data) {
+			for _, variable := range referencedVariables(field.value) {
+				if _, ok := available[variable]; ok {
+					continue
+				}
+				if nodeAvailable == nil {
+					nodeAvailable = make(map[string]struct{})
+					for _, upstream := range upstreamNodes(nodeID, predecessors) {
+						for _, name := range produced[upstream] {
+							nodeAvailable[name] = struct{}{}
+						}
+					}
+				}
+				if _, ok := nodeAvailable[variable]; ok {
+					continue
+				}
+				references = append(references, VariableReference{
+					Path:     field.path,
+					NodeID:   nodeID,
+					Variable: variable,
+					Route:    routeToNode(pathway, nodeID, successors),
+				})
+			}
+		}
+	}
+	return references
+}
+
+// DescribeVariableReference returns the warning detail for an undefined variable reference.
+func DescribeVariableReference(pathway ConversationalPathwayModel, reference VariableReference) string {
+	message := fmt.Sprintf("Node %s references {{%s}}, but no node upstream of it extracts or receives this variable and it is not declared in input_variables.",
+		describeNodeModel(pathway, reference.NodeID), reference.Variable)
+	if len(reference.Route) > 0 {
+		route := make([]string, 0, len(reference.Route))
+		for _, id := range reference.Route {
+			route = append(route, describeNodeModel(pathway, id))
+		}
+		message += fmt.Sprintf("\n\nNode path: %s", strings.Join(route, " -> "))
+	} else {
+		message += "\n\nThe node cannot be reached from a start node."
+	}
+	return message
+}
+
+type variableField struct {
+	path  path.Path
+	value types.String
+}
+
+func variableFields(dataPath path.Path, data ConversationalPathwayNodeDataModel) []variableField {
+	fields := []variableField{
+		{path: dataPath.AtName("prompt"), value: data.Prompt},
+		{path: dataPath.AtName("text"), value: data.Text},
+		{path: dataPath.AtName("body"), value: data.Body},
+		{path: dataPath.AtName("url"), value: data.URL},
+		{path: dataPath.AtName("speech"), value: data.Speech},
+	}
+	for i, header := range data.Headers {
+		fields = append(fields, variableField{path: dataPath.AtName("headers").AtListIndex(i).AtName("value"), value: header.Value})
+	}
+	for _, name := range sortedKeys(data.ToolInputs) {
+		fields = append(fields, variableField{path: dataPath.AtName("tool_inputs").AtMapKey(name), value: data.ToolInputs[name]})
+	}
+	return fields
+}
+
+func referencedVariables(value types.String) []string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	variables := make([]string, 0)
+	for _, match := range pathwayVariablePattern.FindAllStringSubmatch(value.ValueString(), -1) {
+		// Only the root of a nested reference such as {{order.status}} has to be produced.
+		name := strings.FieldsFunc(match[1], func(r rune) bool { return r == '.' || r == '[' })
+		// Secret placeholders such as {{secrets.api_token}} are checked against the secrets of the account instead.
+		if len(name) > 0 && strings.TrimSpace(name[0]) != "secrets" {
+			variables = append(variables, strings.TrimSpace(name[0]))
+		}
+	}
+	return variables
+}
+
+func producedVariables(data ConversationalPathwayNodeDataModel) []string {
+	vars := make([]string, 0, len(data.ExtractVars)+len(data.ResponseData))
+	for _, extractVar := range data.ExtractVars {
+		vars = append(vars, extractVar.Name.ValueString())
+	}
+	for _, responseData := range data.ResponseData {
+		vars = append(vars, responseData.Name.ValueString())
+	}
+	return vars
+}
+
+// pathwayGraph returns the predecessors and successors of every node, following edges, routes, fallbacks and response pathways.
+func pathwayGraph(pathway ConversationalPathwayModel) (predecessors, successors map[string][]string) {
+	predecessors = make(map[string][]string)
+	successors = make(map[string][]string)
+	link := func(source, target string) {
+		if source == "" || target == "" {
+			return
+		}
+		successors[source] = append(successors[source], target)
+		predecessors[target] = append(predecessors[target], source)
+	}
+	for _, edge := range pathway.Edges {
+		link(edge.Source.ValueString(), edge.Target.ValueString())
+	}
+	for _, node := range pathway.Nodes {
+		source := node.ID.ValueString()
+		for _, route := range node.Data.Routes {
+			link(source, route.TargetNodeId.ValueString())
+		}
+		link(source, node.Data.FallbackNodeId.ValueString())
+		for _, responsePathway := range node.Data.ResponsePathways {
+			link(source, responsePathway.Outcome.ID.ValueString())
+		}
+	}
+	return predecessors, successors
+}
+
+// upstreamNodes returns the node and every node with a path to it.
+func upstreamNodes(nodeID string, predecessors map[string][]string) []string {
+	visited := map[string]struct{}{nodeID: {}}
+	queue := []string{nodeID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, previous := range predecessors[current] {
+			if _, ok := visited[previous]; !ok {
+				visited[previous] = struct{}{}
+				queue = append(queue, previous)
+			}
+		}
+	}
+	return sortedKeys(visited)
+}
+
+// routeToNode returns the shortest chain of node ids from a start node to the node, or nil when it is unreachable.
+func routeToNode(pathway ConversationalPathwayModel, nodeID string, successors map[string][]string) []string {
+	previous := make(map[string]string)
+	queue := make([]string, 0)
+	for _, node := range pathway.Nodes {
+		if node.Data.IsStart.ValueBool() {
+			id := node.ID.ValueString()
+			previous[id] = ""
+			queue = append(queue, id)
+		}
+	}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == nodeID {
+			route := []string{current}
+			for previous[current] != "" {
+				current = previous[current]
+				route = append([]string{current}, route...)
+			}
+			return route
+		}
+		next := append([]string(nil), successors[current]...)
+		sort.Strings(next)
+		for _, id := range next {
+			if _, ok := previous[id]; !ok {
+				previous[id] = current
+				queue = append(queue, id)
+			}
+		}
+	}
+	return nil
+}
+
+func describeNodeModel(pathway ConversationalPathwayModel, nodeID string) string {
+	for _, node := range pathway.Nodes {
+		if node.ID.ValueString() == nodeID && node.Data.Name.ValueString() != "" {
+			return fmt.Sprintf("'%s' (%s)", node.Data.Name.ValueString(), nodeID)
+		}
+	}
+	return fmt.Sprintf("'%s'", nodeID)
+}
@@ -0,0 +1,99 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testVariableNode(id, name, prompt string, extractVars ...string) ConversationalPathwayNodeModel {
+	node := ConversationalPathwayNodeModel{
+		ID:   types.StringValue(id),
+		Type: types.StringValue("Default"),
+		Data: ConversationalPathwayNodeDataModel{
+			Name:   types.StringValue(name),
+			Prompt: types.StringValue(prompt),
+		},
+	}
+	for _, extractVar := range extractVars {
+		node.Data.ExtractVars = append(node.Data.ExtractVars, ConversationalPathwayNodeDataExtractVariableModel{Name: types.StringValue(extractVar)})
+	}
+	return node
+}
+
+func testVariableEdge(source, target string) ConversationalPathwayEdgeModel {
+	return ConversationalPathwayEdgeModel{
+		ID:     types.StringValue(source + "-" + target),
+		Source: types.StringValue(source),
+		Target: types.StringValue(target),
+	}
+}
+
+func TestFindUndefinedVariableReferences(t *testing.T) {
+	start := testVariableNode("1", "Start", "Ask for the order number of {{customer_name}}", "order_id")
+	start.Data.IsStart = types.BoolValue(true)
+
+	lookup := testVariableNode("2", "Lookup", "")
+	lookup.Data.Prompt = types.StringNull()
+	lookup.Data.URL = types.StringValue("https://example.com/orders/{{order_id}}")
+	lookup.Data.Headers = []ConversationalPathwayHeaderModel{{Name: types.StringValue("X-Agent"), Value: types.StringValue("{{agent_id}}")}}
+	lookup.Data.ResponseData = []ConversationalPathwayNodeDataResponseDataModel{{Name: types.StringValue("order")}}
+
+	confirm := testVariableNode("3", "Confirm", "Your order is {{ order.status }}, shipped on {{shipped_on}} at {{now}}")
+	orphan := testVariableNode("4", "Orphan", "Hello {{order_id}}")
+	global := testVariableNode("5", "Help", "How can I help?", "help_topic")
+	global.Data.IsGlobal = types.BoolValue(true)
+	topic := testVariableNode("6", "Topic", "Topic: {{help_topic}}")
+
+	pathway := ConversationalPathwayModel{
+		Nodes: []ConversationalPathwayNodeModel{start, lookup, confirm, orphan, global, topic},
+		Edges: []ConversationalPathwayEdgeModel{testVariableEdge("1", "2"), testVariableEdge("2", "3")},
+		InputVariables: types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("customer_name"),
+		}),
+	}
+
+	references := FindUndefinedVariableReferences(pathway)
+	expected := []struct {
+		variable string
+		path     path.Path
+	}{
+		{"agent_id", path.Root("nodes").AtListIndex(1).AtName("data").AtName("headers").AtListIndex(0).AtName("value")},
+		{"shipped_on", path.Root("nodes").AtListIndex(2).AtName("data").AtName("prompt")},
+		{"order_id", path.Root("nodes").AtListIndex(3).AtName("data").AtName("prompt")},
+	}
+	if len(references) != len(expected) {
+		t.Fatalf("expected %d references, got %d: %v", len(expected), len(references), references)
+	}
+	for i, reference := range references {
+		if reference.Variable != expected[i].variable || !reference.Path.Equal(expected[i].path) {
+			t.Errorf("expected %s at %s, got %s at %s", expected[i].variable, expected[i].path, reference.Variable, reference.Path)
+		}
+	}
+
+	detail := DescribeVariableReference(pathway, references[1])
+	if !strings.Contains(detail, "Node path: 'Start' (1) -> 'Lookup' (2) -> 'Confirm' (3)") {
+		t.Errorf("expected node path in detail, got %q", detail)
+	}
+	detail = DescribeVariableReference(pathway, references[2])
+	if !strings.Contains(detail, "cannot be reached from a start node") {
+		t.Errorf("expected unreachable node in detail, got %q", detail)
+	}
+}
+
+func TestFindUndefinedVariableReferences_Clone(t *testing.T) {
+	pathway := ConversationalPathwayModel{
+		SourcePathwayID: types.StringValue("source-123"),
+		Nodes:           []ConversationalPathwayNodeModel{testVariableNode("1", "Confirm", "Your order is {{order_id}}")},
+	}
+
+	if references := FindUndefinedVariableReferences(pathway); len(references) != 0 {
+		t.Errorf("expected no references for a cloned pathway, got %v", references)
+	}
+}
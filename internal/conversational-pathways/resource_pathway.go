@@ -8,27 +8,43 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jameshiester/terraform-provider-bland/internal/api"
+	"github.com/jameshiester/terraform-provider-bland/internal/secret"
 	utils "github.com/jameshiester/terraform-provider-bland/internal/util"
 )
 
+const (
+	PATHWAY_NODE_TYPE_CUSTOM_TOOL = "Custom Tool"
+)
+
 var _ resource.Resource = &ConversationalPathwayResource{}
 var _ resource.ResourceWithImportState = &ConversationalPathwayResource{}
+var _ resource.ResourceWithModifyPlan = &ConversationalPathwayResource{}
+var _ resource.ResourceWithValidateConfig = &ConversationalPathwayResource{}
 
 type ConversationalPathwayResource struct {
 	utils.TypeInfo
 	PathwayClient client
+	SecretClient  *secret.SecretClient
 }
 
 func NewConversationalPathwayResource() resource.Resource {
@@ -55,7 +71,7 @@ func (r *ConversationalPathwayResource) Schema(ctx context.Context, req resource
 	_, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
 	defer exitContext()
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a [Conversational Pathway](https://docs.bland.ai/tutorials/pathways).",
+		MarkdownDescription: "Manages a [Conversational Pathway](https://docs.bland.ai/tutorials/pathways).\n\nPlans that change an existing pathway emit a warning summarizing the changes by node and edge, such as renamed nodes, prompt edits and retargeted edges.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Unique pathway id",
@@ -189,7 +205,7 @@ func (r *ConversationalPathwayResource) Schema(ctx context.Context, req resource
 									},
 								},
 								"response_data": schema.ListNestedAttribute{
-									MarkdownDescription: "Response data for the node.",
+									MarkdownDescription: "Response data for the node. On webhook and `Custom Tool` nodes this maps fields of the response to variables.",
 									Optional:            true,
 									NestedObject: schema.NestedAttributeObject{
 										Attributes: map[string]schema.Attribute{
@@ -327,6 +343,14 @@ func (r *ConversationalPathwayResource) Schema(ctx context.Context, req resource
 										"token": schema.StringAttribute{
 											MarkdownDescription: "Auth token.",
 											Optional:            true,
+											Sensitive:           true,
+											Validators: []validator.String{
+												stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("token_secret")),
+											},
+										},
+										"token_secret": schema.StringAttribute{
+											MarkdownDescription: "ID or name of a `bland_secret` holding the auth token. The token is sent to Bland as a secret placeholder, so its value never appears in the pathway.",
+											Optional:            true,
 										},
 										"encode": schema.BoolAttribute{
 											MarkdownDescription: "Whether to encode the token.",
@@ -335,8 +359,9 @@ func (r *ConversationalPathwayResource) Schema(ctx context.Context, req resource
 									},
 								},
 								"body": schema.StringAttribute{
-									MarkdownDescription: "Body for the node.",
+									MarkdownDescription: "Body for the node. Secrets can be referenced with `{{secrets.<name>}}`.",
 									Optional:            true,
+									Sensitive:           true,
 								},
 								"headers": schema.ListNestedAttribute{
 									MarkdownDescription: "Headers for the node.",
@@ -350,6 +375,14 @@ func (r *ConversationalPathwayResource) Schema(ctx context.Context, req resource
 											"value": schema.StringAttribute{
 												MarkdownDescription: "Header value.",
 												Optional:            true,
+												Sensitive:           true,
+												Validators: []validator.String{
+													stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("secret")),
+												},
+											},
+											"secret": schema.StringAttribute{
+												MarkdownDescription: "ID or name of a `bland_secret` holding the header value. The value is sent to Bland as a secret placeholder, so it never appears in the pathway.",
+												Optional:            true,
 											},
 										},
 									},
@@ -402,6 +435,25 @@ func (r *ConversationalPathwayResource) Schema(ctx context.Context, req resource
 									MarkdownDescription: "Maximum number of retries for the node.",
 									Optional:            true,
 								},
+								"tool_id": schema.StringAttribute{
+									MarkdownDescription: "ID of the custom tool invoked by a `Custom Tool` node.",
+									Optional:            true,
+								},
+								"tool_inputs": schema.MapAttribute{
+									MarkdownDescription: "Values bound to the inputs of the custom tool, keyed by input name. Values may reference variables, e.g. `{{customer_id}}`. All inputs the tool requires must be bound.",
+									ElementType:         types.StringType,
+									Optional:            true,
+									Validators: []validator.Map{
+										mapvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("tool_id")),
+									},
+								},
+								"speech": schema.StringAttribute{
+									MarkdownDescription: "Text spoken while the custom tool is executing.",
+									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("tool_id")),
+									},
+								},
 							},
 						},
 					},
@@ -492,6 +544,105 @@ func (r *ConversationalPathwayResource) Schema(ctx context.Context, req resource
 						MarkdownDescription: "Global prompt for the pathway.",
 						Optional:            true,
 					},
+					"model_options": schema.SingleNestedAttribute{
+						MarkdownDescription: "Default model options for nodes of the pathway. Node level `model_options` take precedence.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"model_type": schema.StringAttribute{
+								MarkdownDescription: "Type of the model.",
+								Required:            true,
+							},
+							"interruption_threshold": schema.StringAttribute{
+								MarkdownDescription: "Interruption threshold for the model.",
+								Optional:            true,
+							},
+							"temperature": schema.Float32Attribute{
+								MarkdownDescription: "Temperature setting for the model.",
+								Optional:            true,
+							},
+							"skip_user_response": schema.BoolAttribute{
+								MarkdownDescription: "Whether to skip user response.",
+								Optional:            true,
+							},
+							"block_interruptions": schema.BoolAttribute{
+								MarkdownDescription: "Whether to block interruptions.",
+								Optional:            true,
+							},
+						},
+					},
+					"voice": schema.StringAttribute{
+						MarkdownDescription: "Voice used on calls following the pathway.",
+						Optional:            true,
+					},
+					"first_sentence": schema.StringAttribute{
+						MarkdownDescription: "First sentence spoken at the start of the call.",
+						Optional:            true,
+					},
+					"kb": schema.StringAttribute{
+						MarkdownDescription: "Default knowledge base for nodes of the pathway.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("kb_tool")),
+						},
+					},
+					"kb_tool": schema.StringAttribute{
+						MarkdownDescription: "Default knowledge base tool for nodes of the pathway.",
+						Optional:            true,
+					},
+				},
+			},
+			"version_number": schema.Int64Attribute{
+				MarkdownDescription: "Version of the draft last written or read by Terraform.",
+				Computed:            true,
+			},
+			"revision_number": schema.Int64Attribute{
+				MarkdownDescription: "Revision of the draft last written or read by Terraform. Updates fail if the draft was edited since, unless `force_overwrite` is set.",
+				Computed:            true,
+			},
+			"force_overwrite": schema.BoolAttribute{
+				MarkdownDescription: "Overwrite the draft even if it was edited outside of Terraform since it was last read.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"post_call_actions": schema.ListAttribute{
+				MarkdownDescription: "Actions to run after a call on the pathway ends. When not set, the actions configured in the Bland UI are kept.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"input_variables": schema.ListAttribute{
+				MarkdownDescription: "Variables passed to the pathway when a call starts, e.g. through `request_data`. They are only used to check `{{variable}}` references in nodes at plan time and are not sent to Bland.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"folder_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the `bland_pathway_folder` the pathway is filed in. Changing this moves the pathway without recreating it. Set to `\"\"` to move the pathway to the top level. When not set, the folder chosen in the Bland UI is kept.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_pathway_id": schema.StringAttribute{
+				MarkdownDescription: "ID of an existing pathway to clone. The new pathway is seeded with the nodes, edges and global config of the source, and the nodes, edges and global config configured here are applied on top, matched by ID. Only the configured nodes and edges are tracked by Terraform. Changing this forces a new pathway to be created.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_version_number": schema.Int64Attribute{
+				MarkdownDescription: "Version of the source pathway to clone. Defaults to the current draft of the source pathway. Changing this forces a new pathway to be created.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AlsoRequires(path.MatchRoot("source_pathway_id")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
 				},
 			},
 		},
@@ -515,12 +666,181 @@ func (r *ConversationalPathwayResource) Configure(ctx context.Context, req resou
 		return
 	}
 	r.PathwayClient = newPathwayClient(client.Api)
+	r.SecretClient = &secret.SecretClient{Api: client.Api}
+}
+
+func (r *ConversationalPathwayResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Variable references and duplicate ids are checked on nodes and edges written out in the configuration, nodes built
+	// from values that are not known before apply are skipped here.
+	if !pathwayGraphKnown(req.Config.Raw) {
+		return
+	}
+	var config ConversationalPathwayModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, reference := range FindUndefinedVariableReferences(config) {
+		resp.Diagnostics.AddAttributeWarning(reference.Path, "Undefined variable reference", DescribeVariableReference(config, reference))
+	}
+	resp.Diagnostics.Append(validateUniqueIDs(config)...)
+}
+
+// validateUniqueIDs checks that node and edge ids are unique, which concatenating fragments or copy-pasting nodes can break.
+func validateUniqueIDs(config ConversationalPathwayModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	nodes := make(map[string]int, len(config.Nodes))
+	for i, node := range config.Nodes {
+		if node.ID.IsNull() || node.ID.IsUnknown() {
+			continue
+		}
+		if first, ok := nodes[node.ID.ValueString()]; ok {
+			diags.AddAttributeError(path.Root("nodes").AtListIndex(i).AtName("id"), "Duplicate node ID",
+				fmt.Sprintf("Node id '%s' is used by nodes %d and %d. Node ids must be unique within a pathway, use a different prefix when including a fragment more than once.", node.ID.ValueString(), first, i))
+			continue
+		}
+		nodes[node.ID.ValueString()] = i
+	}
+	edges := make(map[string]int, len(config.Edges))
+	for i, edge := range config.Edges {
+		if edge.ID.IsNull() || edge.ID.IsUnknown() {
+			continue
+		}
+		if first, ok := edges[edge.ID.ValueString()]; ok {
+			diags.AddAttributeError(path.Root("edges").AtListIndex(i).AtName("id"), "Duplicate edge ID",
+				fmt.Sprintf("Edge id '%s' is used by edges %d and %d. Edge ids must be unique within a pathway, use a different prefix when including a fragment more than once.", edge.ID.ValueString(), first, i))
+			continue
+		}
+		edges[edge.ID.ValueString()] = i
+	}
+	return diags
+}
+
+func (r *ConversationalPathwayResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Tool nodes and secret references are checked once the nodes are known, which for nodes built from other
+	// resources is only the case when the plan is made again during apply.
+	if !pathwayGraphKnown(req.Plan.Raw) {
+		return
+	}
+	var plan ConversationalPathwayModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.validateToolNodes(ctx, plan)...)
+	resp.Diagnostics.Append(r.validateSecretReferences(ctx, plan)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var state ConversationalPathwayModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Nested node lists make a single prompt edit show up as a wall of plan noise, summarize the changes by node and edge instead.
+	if changes := DescribePlannedPathwayChanges(state, plan); len(changes) > 0 {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Changes to pathway '%s'", plan.Name.ValueString()),
+			"Planned changes to the pathway:\n  - "+strings.Join(changes, "\n  - "))
+	}
+}
+
+// pathwayGraphKnown reports whether the nodes, edges and global config of a pathway are fully known. They are read into
+// Go structs, which cannot hold unknown values.
+func pathwayGraphKnown(raw tftypes.Value) bool {
+	var attributes map[string]tftypes.Value
+	if err := raw.As(&attributes); err != nil {
+		return false
+	}
+	for _, name := range []string{"nodes", "edges", "global_config"} {
+		if !attributes[name].IsFullyKnown() {
+			return false
+		}
+	}
+	return true
+}
+
+// validateSecretReferences checks that every secret referenced by the nodes exists.
+func (r *ConversationalPathwayResource) validateSecretReferences(ctx context.Context, plan ConversationalPathwayModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	references := FindSecretReferences(plan)
+	if len(references) == 0 || r.SecretClient == nil || r.SecretClient.Api == nil {
+		return diags
+	}
+
+	resolver := newSecretResolver(r.SecretClient)
+	for _, reference := range references {
+		_, found, err := resolver.resolve(ctx, reference.Ref)
+		if err != nil {
+			diags.AddError("Client error when listing secrets", err.Error())
+			return diags
+		}
+		if !found {
+			diags.AddAttributeError(reference.Path, "Secret not found",
+				fmt.Sprintf("Node %s references secret '%s', but no secret with this ID or name exists.", describeNodeModel(plan, reference.NodeID), reference.Ref))
+		}
+	}
+	return diags
+}
+
+// validateToolNodes checks that Custom Tool nodes reference an existing tool and bind all of its required inputs.
+func (r *ConversationalPathwayResource) validateToolNodes(ctx context.Context, plan ConversationalPathwayModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	tools := make(map[string]*toolDto)
+	for i, node := range plan.Nodes {
+		dataPath := path.Root("nodes").AtListIndex(i).AtName("data")
+		if node.Type.ValueString() == PATHWAY_NODE_TYPE_CUSTOM_TOOL && node.Data.ToolID.IsNull() {
+			diags.AddAttributeError(dataPath.AtName("tool_id"), "Missing tool ID",
+				fmt.Sprintf("Node '%s' is a %s node and must set tool_id.", node.Data.Name.ValueString(), PATHWAY_NODE_TYPE_CUSTOM_TOOL))
+			continue
+		}
+		if node.Data.ToolID.IsNull() || node.Data.ToolID.IsUnknown() || r.PathwayClient.Api == nil {
+			continue
+		}
+
+		toolID := node.Data.ToolID.ValueString()
+		tool, ok := tools[toolID]
+		if !ok {
+			var err error
+			tool, err = r.PathwayClient.GetTool(ctx, toolID)
+			if err != nil {
+				if errors.Is(err, api.ErrObjectNotFound) {
+					diags.AddAttributeError(dataPath.AtName("tool_id"), "Custom tool not found",
+						fmt.Sprintf("Node '%s' references tool %s which does not exist.", node.Data.Name.ValueString(), toolID))
+					continue
+				}
+				diags.AddAttributeError(dataPath.AtName("tool_id"), fmt.Sprintf("Client error when reading tool %s", toolID), err.Error())
+				continue
+			}
+			tools[toolID] = tool
+		}
+
+		inputs := make(map[string]string, len(node.Data.ToolInputs))
+		for name, value := range node.Data.ToolInputs {
+			inputs[name] = value.ValueString()
+		}
+		if missing := MissingToolInputs(*tool, inputs); len(missing) > 0 {
+			diags.AddAttributeError(dataPath.AtName("tool_inputs"), "Required tool inputs not bound",
+				fmt.Sprintf("Node '%s' does not bind the required inputs of tool %s: %s.", node.Data.Name.ValueString(), toolID, strings.Join(missing, ", ")))
+		}
+	}
+	return diags
 }
 
 func (r *ConversationalPathwayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
 	defer exitContext()
-	var plan ConversationalPathwayDataSourceModel
+	var plan ConversationalPathwayModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
@@ -529,12 +849,27 @@ func (r *ConversationalPathwayResource) Create(ctx context.Context, req resource
 	}
 
 	dto := ConvertFromPathwayModel(plan)
+	secrets := newSecretResolver(r.SecretClient)
+	if err := renderSecretReferences(ctx, &dto, plan, secrets); err != nil {
+		resp.Diagnostics.AddError("Failed to resolve secret references", err.Error())
+		return
+	}
+	isClone := !plan.SourcePathwayID.IsNull()
+	if isClone {
+		source, err := r.PathwayClient.getSourcePathway(ctx, plan)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading source pathway %s", plan.SourcePathwayID.ValueString()), err.Error())
+			return
+		}
+		dto = OverlayPathway(*source, dto, nil, nil)
+	}
 
 	modelToCreate := createPathwayDto{
-		Name:        dto.Name,
-		Description: dto.Description,
-		Nodes:       dto.Nodes,
-		Edges:       dto.Edges,
+		Name:            dto.Name,
+		Description:     dto.Description,
+		Nodes:           dto.Nodes,
+		Edges:           dto.Edges,
+		PostCallActions: dto.PostCallActions,
 	}
 
 	connection, err := r.PathwayClient.CreatePathway(ctx, modelToCreate)
@@ -542,18 +877,44 @@ func (r *ConversationalPathwayResource) Create(ctx context.Context, req resource
 		resp.Diagnostics.AddError("Failed to create pathway", err.Error())
 		return
 	}
+	if isClone {
+		filtered := FilterManagedPathway(*connection, plan)
+		connection = &filtered
+	}
 
 	responseModel, err := ConvertFromPathwayDto(*connection)
 	if err != nil {
 		resp.Diagnostics.AddError("Error occurred when parsing create pathway response", err.Error())
 		return
 	}
+	if err := restoreSecretReferences(ctx, responseModel, plan, secrets); err != nil {
+		resp.Diagnostics.AddError("Failed to resolve secret references", err.Error())
+		return
+	}
 	plan.ID = responseModel.ID
 	plan.Description = responseModel.Description
 	plan.Name = responseModel.Name
 	plan.Nodes = responseModel.Nodes
 	plan.Edges = responseModel.Edges
 	plan.GlobalConfig = responseModel.GlobalConfig
+	plan.PostCallActions = responseModel.PostCallActions
+
+	// The pathway exists from here on, errors are reported along with the state so Terraform taints the pathway instead
+	// of creating a duplicate on the next apply.
+	if plan.FolderID.IsUnknown() || plan.FolderID.IsNull() {
+		plan.FolderID = types.StringNull()
+	} else if folderID := pathwayFolderID(plan.FolderID); folderID != nil {
+		if err := r.PathwayClient.MovePathway(ctx, plan.ID.ValueString(), folderID); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when moving %s to folder %s", r.FullTypeName(), *folderID), err.Error())
+			plan.FolderID = types.StringNull()
+		}
+	}
+
+	versions, err := r.PathwayClient.GetPathwayVersions(ctx, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error fetching latest version %s", r.FullTypeName()), err.Error())
+	}
+	plan.VersionNumber, plan.RevisionNumber = latestDraftRevision(versions)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -561,7 +922,7 @@ func (r *ConversationalPathwayResource) Read(ctx context.Context, req resource.R
 	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
 	defer exitContext()
 
-	var state *ConversationalPathwayDataSourceModel
+	var state *ConversationalPathwayModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -576,17 +937,40 @@ func (r *ConversationalPathwayResource) Read(ctx context.Context, req resource.R
 		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
 		return
 	}
+	if !state.SourcePathwayID.IsNull() {
+		filtered := FilterManagedPathway(*pathway, *state)
+		pathway = &filtered
+	}
 
 	model, err := ConvertFromPathwayDto(*pathway)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Error when converting %s", r.FullTypeName()), err.Error())
 		return
 	}
+	if err := restoreSecretReferences(ctx, model, *state, newSecretResolver(r.SecretClient)); err != nil {
+		resp.Diagnostics.AddError("Failed to resolve secret references", err.Error())
+		return
+	}
 	state.Name = model.Name
 	state.Description = model.Description
 	state.Nodes = model.Nodes
 	state.Edges = model.Edges
 	state.GlobalConfig = model.GlobalConfig
+	state.PostCallActions = model.PostCallActions
+	if !state.FolderID.Equal(types.StringValue("")) || !model.FolderID.IsNull() {
+		// Keep the top level sentinel while the pathway is not filed in a folder.
+		state.FolderID = model.FolderID
+	}
+
+	versions, err := r.PathwayClient.GetPathwayVersions(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error fetching latest version %s", r.FullTypeName()), err.Error())
+		return
+	}
+	state.VersionNumber, state.RevisionNumber = latestDraftRevision(versions)
+	if state.ForceOverwrite.IsNull() {
+		state.ForceOverwrite = types.BoolValue(false)
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -599,20 +983,72 @@ func FindLatestUnpublishedVersion(versions []pathwayVersionDto) (versionNumber,
 	return 0, 0, false
 }
 
+// pathwayFolderID returns the folder a pathway is moved to, nil for the top level.
+func pathwayFolderID(folderID types.String) *string {
+	if folderID.ValueString() == "" {
+		return nil
+	}
+	return folderID.ValueStringPointer()
+}
+
+// latestDraftRevision returns the version and revision of the draft, or nulls when there is none.
+func latestDraftRevision(versions []pathwayVersionDto) (types.Int64, types.Int64) {
+	versionNumber, revisionNumber, found := FindLatestUnpublishedVersion(versions)
+	if !found {
+		return types.Int64Null(), types.Int64Null()
+	}
+	return types.Int64Value(int64(versionNumber)), types.Int64Value(int64(revisionNumber))
+}
+
+// checkDraftUnchanged returns an error describing the changes if the draft moved on since the state was last written or read.
+func (r *ConversationalPathwayResource) checkDraftUnchanged(ctx context.Context, state ConversationalPathwayModel, latestVersion, latestRevision int) error {
+	if state.VersionNumber.IsNull() || state.RevisionNumber.IsNull() {
+		return nil
+	}
+	if state.VersionNumber.ValueInt64() == int64(latestVersion) && state.RevisionNumber.ValueInt64() == int64(latestRevision) {
+		return nil
+	}
+
+	message := fmt.Sprintf("The draft of pathway %s moved from version %d revision %d to version %d revision %d since Terraform last read it.",
+		state.ID.ValueString(), state.VersionNumber.ValueInt64(), state.RevisionNumber.ValueInt64(), latestVersion, latestRevision)
+
+	current, err := r.PathwayClient.GetPathway(ctx, state.ID.ValueString())
+	if err == nil && !state.SourcePathwayID.IsNull() {
+		filtered := FilterManagedPathway(*current, state)
+		current = &filtered
+	}
+	if err == nil {
+		currentModel, err := ConvertFromPathwayDto(*current)
+		if err == nil {
+			changes := SummarizePathwayChanges(ConvertFromPathwayModel(state), ConvertFromPathwayModel(*currentModel))
+			if len(changes) > 0 {
+				message += "\n\nChanges made outside of Terraform:\n  - " + strings.Join(changes, "\n  - ")
+			}
+		}
+	}
+
+	return fmt.Errorf("%s\n\nRefresh and review the plan to keep these edits, or set force_overwrite = true to overwrite them", message)
+}
+
 func (r *ConversationalPathwayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
 	defer exitContext()
 
-	var plan ConversationalPathwayDataSourceModel
+	var plan ConversationalPathwayModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
-	var state ConversationalPathwayDataSourceModel
+	var state ConversationalPathwayModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	dto := ConvertFromPathwayModel(plan)
+	secrets := newSecretResolver(r.SecretClient)
+	if err := renderSecretReferences(ctx, &dto, plan, secrets); err != nil {
+		resp.Diagnostics.AddError("Failed to resolve secret references", err.Error())
+		return
+	}
 
 	versions, err := r.PathwayClient.GetPathwayVersions(ctx, plan.ID.ValueString())
 	if err != nil {
@@ -625,6 +1061,26 @@ func (r *ConversationalPathwayResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	if !plan.ForceOverwrite.ValueBool() {
+		err = r.checkDraftUnchanged(ctx, state, latestVersion, latestRevision)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Pathway draft changed outside of Terraform %s", r.FullTypeName()), err.Error())
+			return
+		}
+	}
+
+	isClone := !plan.SourcePathwayID.IsNull()
+	if isClone {
+		// Nodes and edges inherited from the source pathway are not in the plan, keep them on the draft.
+		current, err := r.PathwayClient.GetPathway(ctx, plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+			return
+		}
+		removedNodeIDs, removedEdgeIDs := removedPathwayIDs(state, plan)
+		dto = OverlayPathway(*current, dto, removedNodeIDs, removedEdgeIDs)
+	}
+
 	updateParams := updatePathwayDto{
 		ID:          plan.ID.ValueString(),
 		Name:        dto.Name,
@@ -634,23 +1090,52 @@ func (r *ConversationalPathwayResource) Update(ctx context.Context, req resource
 		Version:     latestVersion,
 		Revision:    latestRevision,
 	}
+	if dto.PostCallActions != nil {
+		// An empty list clears the actions, unset actions are kept as configured in the Bland UI.
+		updateParams.PostCallActions = &dto.PostCallActions
+	}
 
 	updateReponse, err := r.PathwayClient.UpdatePathway(ctx, plan.ID.ValueString(), updateParams)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.FullTypeName()), err.Error())
 		return
 	}
+	if isClone {
+		filtered := FilterManagedPathway(*updateReponse, plan)
+		updateReponse = &filtered
+	}
 
 	modelState, err := ConvertFromPathwayDto(*updateReponse)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Error when converting updated %s", r.FullTypeName()), err.Error())
 		return
 	}
+	if err := restoreSecretReferences(ctx, modelState, plan, secrets); err != nil {
+		resp.Diagnostics.AddError("Failed to resolve secret references", err.Error())
+		return
+	}
 	plan.Name = modelState.Name
 	plan.Description = modelState.Description
 	plan.Nodes = modelState.Nodes
 	plan.Edges = modelState.Edges
 	plan.GlobalConfig = modelState.GlobalConfig
+	plan.PostCallActions = modelState.PostCallActions
+
+	if plan.FolderID.IsUnknown() {
+		plan.FolderID = state.FolderID
+	} else if !plan.FolderID.Equal(state.FolderID) {
+		if err := r.PathwayClient.MovePathway(ctx, plan.ID.ValueString(), pathwayFolderID(plan.FolderID)); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when moving %s to folder %s", r.FullTypeName(), plan.FolderID.ValueString()), err.Error())
+			return
+		}
+	}
+
+	versions, err = r.PathwayClient.GetPathwayVersions(ctx, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error fetching latest version %s", r.FullTypeName()), err.Error())
+		return
+	}
+	plan.VersionNumber, plan.RevisionNumber = latestDraftRevision(versions)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -658,7 +1143,7 @@ func (r *ConversationalPathwayResource) Delete(ctx context.Context, req resource
 	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
 	defer exitContext()
 
-	var state *ConversationalPathwayDataSourceModel
+	var state *ConversationalPathwayModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
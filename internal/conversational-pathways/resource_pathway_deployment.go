@@ -0,0 +1,312 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jameshiester/terraform-provider-bland/internal/api"
+	utils "github.com/jameshiester/terraform-provider-bland/internal/util"
+)
+
+const (
+	PATHWAY_ENVIRONMENT_STAGING    = "staging"
+	PATHWAY_ENVIRONMENT_PRODUCTION = "production"
+	PATHWAY_VERSION_LATEST         = "latest"
+)
+
+var _ resource.Resource = &ConversationalPathwayDeploymentResource{}
+var _ resource.ResourceWithModifyPlan = &ConversationalPathwayDeploymentResource{}
+
+type ConversationalPathwayDeploymentResource struct {
+	utils.TypeInfo
+	PathwayClient client
+}
+
+func NewConversationalPathwayDeploymentResource() resource.Resource {
+	return &ConversationalPathwayDeploymentResource{
+		TypeInfo: utils.TypeInfo{
+			TypeName: "conversational_pathway_deployment",
+		},
+	}
+}
+
+func (r *ConversationalPathwayDeploymentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *ConversationalPathwayDeploymentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	_, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Publishes a version of a [Conversational Pathway](https://docs.bland.ai/tutorials/pathways) and promotes it to the `staging` or `production` environment. " +
+			"Destroying this resource only removes it from state, the promoted version stays live.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Deployment id in the format `<pathway_id>/<environment>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pathway_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the pathway to deploy.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version number to publish, or `latest` to publish the most recent version of the pathway.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^(latest|[1-9][0-9]*)$`),
+						"must be a positive version number or `latest`",
+					),
+				},
+			},
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "Environment to promote the version to. One of `staging` or `production`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(PATHWAY_ENVIRONMENT_STAGING, PATHWAY_ENVIRONMENT_PRODUCTION),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deployed_version_number": schema.Int64Attribute{
+				MarkdownDescription: "Version number currently promoted to the environment. A version promoted outside of Terraform shows up as drift.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ConversationalPathwayDeploymentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	_, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.PathwayClient = newPathwayClient(client.Api)
+}
+
+func (r *ConversationalPathwayDeploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ConversationalPathwayDeploymentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.PathwayID.IsUnknown() || plan.Version.IsUnknown() || plan.Version.IsNull() {
+		return
+	}
+
+	var deployed types.Int64
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deployed_version_number"), &deployed)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	desired, ok := parseDeploymentVersion(plan.Version.ValueString())
+	if !ok {
+		// Resolving "latest" needs the pathway to exist already.
+		if req.State.Raw.IsNull() || r.PathwayClient.Api == nil {
+			return
+		}
+		versions, err := r.PathwayClient.GetPathwayVersions(ctx, plan.PathwayID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error fetching versions for %s", r.FullTypeName()), err.Error())
+			return
+		}
+		desired, ok = FindLatestVersion(versions)
+		if !ok {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when finding latest version %s", r.FullTypeName()), "Could not find latest version")
+			return
+		}
+	}
+
+	if !deployed.IsNull() && !deployed.IsUnknown() && deployed.ValueInt64() == int64(desired) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("deployed_version_number"), deployed)...)
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("deployed_version_number"), types.Int64Value(int64(desired)))...)
+}
+
+func (r *ConversationalPathwayDeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan ConversationalPathwayDeploymentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versionNumber, err := r.deploy(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deploying %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.PathwayID.ValueString(), plan.Environment.ValueString()))
+	plan.DeployedVersionNumber = types.Int64Value(int64(versionNumber))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ConversationalPathwayDeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state ConversationalPathwayDeploymentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versions, err := r.PathwayClient.GetPathwayVersions(ctx, state.PathwayID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	promoted, found := FindPromotedVersion(versions, state.Environment.ValueString())
+	if !found {
+		tflog.Debug(ctx, fmt.Sprintf("No version of pathway %s is promoted to %s", state.PathwayID.ValueString(), state.Environment.ValueString()))
+		state.DeployedVersionNumber = types.Int64Null()
+	} else {
+		state.DeployedVersionNumber = types.Int64Value(int64(promoted))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ConversationalPathwayDeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan ConversationalPathwayDeploymentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versionNumber, err := r.deploy(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deploying %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	plan.DeployedVersionNumber = types.Int64Value(int64(versionNumber))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ConversationalPathwayDeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Bland has no way to withdraw a promoted version, so the deployment is only forgotten.
+	tflog.Debug(ctx, fmt.Sprintf("Removing %s from state, the promoted version is left in place", r.FullTypeName()))
+}
+
+// deploy publishes the planned version to the configured environment. `latest` is only resolved again when the plan
+// could not resolve it, so a version created between plan and apply is not published instead of the planned one.
+func (r *ConversationalPathwayDeploymentResource) deploy(ctx context.Context, plan ConversationalPathwayDeploymentModel) (int, error) {
+	versionNumber, ok := parseDeploymentVersion(plan.Version.ValueString())
+	if !plan.DeployedVersionNumber.IsNull() && !plan.DeployedVersionNumber.IsUnknown() {
+		versionNumber, ok = int(plan.DeployedVersionNumber.ValueInt64()), true
+	}
+	if !ok {
+		versions, err := r.PathwayClient.GetPathwayVersions(ctx, plan.PathwayID.ValueString())
+		if err != nil {
+			return 0, err
+		}
+		versionNumber, ok = FindLatestVersion(versions)
+		if !ok {
+			return 0, fmt.Errorf("could not find latest version of pathway %s", plan.PathwayID.ValueString())
+		}
+	}
+
+	err := r.PathwayClient.PublishPathway(ctx, plan.PathwayID.ValueString(), versionNumber, plan.Environment.ValueString())
+	if err != nil {
+		return 0, err
+	}
+	return versionNumber, nil
+}
+
+// parseDeploymentVersion returns the version number for an explicit version and false for `latest`.
+func parseDeploymentVersion(version string) (int, bool) {
+	if version == PATHWAY_VERSION_LATEST {
+		return 0, false
+	}
+	versionNumber, err := strconv.Atoi(version)
+	if err != nil {
+		return 0, false
+	}
+	return versionNumber, true
+}
+
+// FindLatestVersion returns the highest version number from versions sorted by GetPathwayVersions.
+func FindLatestVersion(versions []pathwayVersionDto) (versionNumber int, found bool) {
+	if len(versions) == 0 {
+		return 0, false
+	}
+	return versions[0].VersionNumber, true
+}
+
+// FindPromotedVersion returns the version number currently promoted to the given environment.
+func FindPromotedVersion(versions []pathwayVersionDto, environment string) (versionNumber int, found bool) {
+	for _, v := range versions {
+		switch environment {
+		case PATHWAY_ENVIRONMENT_PRODUCTION:
+			if v.IsProduction != nil && *v.IsProduction {
+				return v.VersionNumber, true
+			}
+		case PATHWAY_ENVIRONMENT_STAGING:
+			if v.IsStaging != nil && *v.IsStaging {
+				return v.VersionNumber, true
+			}
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,52 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jameshiester/terraform-provider-bland/internal/mocks"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestUnitConversationalPathwayDeploymentResource_Validate_Create(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/v1/pathway/123/publish",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/resource/deployment/Validate_Create/publish_pathway.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bland.ai/v1/pathway/123/versions`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/resource/deployment/Validate_Create/get_pathway_versions.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "bland_conversational_pathway_deployment" "prod" {
+						pathway_id  = "123"
+						version     = "2"
+						environment = "production"
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bland_conversational_pathway_deployment.prod", "id", "123/production"),
+					resource.TestCheckResourceAttr("bland_conversational_pathway_deployment.prod", "pathway_id", "123"),
+					resource.TestCheckResourceAttr("bland_conversational_pathway_deployment.prod", "version", "2"),
+					resource.TestCheckResourceAttr("bland_conversational_pathway_deployment.prod", "environment", "production"),
+					resource.TestCheckResourceAttr("bland_conversational_pathway_deployment.prod", "deployed_version_number", "2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,195 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jameshiester/terraform-provider-bland/internal/api"
+	utils "github.com/jameshiester/terraform-provider-bland/internal/util"
+)
+
+var _ resource.Resource = &PathwayFolderResource{}
+var _ resource.ResourceWithImportState = &PathwayFolderResource{}
+
+type PathwayFolderResource struct {
+	utils.TypeInfo
+	PathwayClient client
+}
+
+func NewPathwayFolderResource() resource.Resource {
+	return &PathwayFolderResource{
+		TypeInfo: utils.TypeInfo{
+			TypeName: "pathway_folder",
+		},
+	}
+}
+
+func (r *PathwayFolderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *PathwayFolderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	_, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Folder used to organize [Conversational Pathways](https://docs.bland.ai/tutorials/pathways) in the Bland dashboard. " +
+			"Pathways are filed in a folder through the `folder_id` attribute of `bland_conversational_pathway`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Folder id.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the folder.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"parent_folder_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the folder this folder is nested in. Folders without a parent are shown at the top level. Changing this moves the folder and the pathways in it.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *PathwayFolderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	_, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.PathwayClient = newPathwayClient(client.Api)
+}
+
+func (r *PathwayFolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan PathwayFolderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder, err := r.PathwayClient.CreatePathwayFolder(ctx, createPathwayFolderDto{
+		Name:           plan.Name.ValueString(),
+		ParentFolderID: plan.ParentFolderID.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(folder.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PathwayFolderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state PathwayFolderModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder, err := r.PathwayClient.GetPathwayFolder(ctx, state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrObjectNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(folder.Name)
+	state.ParentFolderID = types.StringPointerValue(folder.ParentFolderID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PathwayFolderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan PathwayFolderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.PathwayClient.UpdatePathwayFolder(ctx, plan.ID.ValueString(), updatePathwayFolderDto{
+		Name:           plan.Name.ValueString(),
+		ParentFolderID: plan.ParentFolderID.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PathwayFolderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state PathwayFolderModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.PathwayClient.DeletePathwayFolder(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+		return
+	}
+}
+
+func (r *PathwayFolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jameshiester/terraform-provider-bland/internal/mocks"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestUnitPathwayFolderResource_Validate_Create_And_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	updated := false
+
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/v1/pathway/folders",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/resource/pathway_folder/Validate_Create/post_folder.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://api.bland.ai/v1/pathway/folders",
+		func(req *http.Request) (*http.Response, error) {
+			if updated {
+				return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/resource/pathway_folder/Validate_Create/get_folders_updated.json").String()), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/resource/pathway_folder/Validate_Create/get_folders.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("PATCH", "https://api.bland.ai/v1/pathway/folders/folder-1",
+		func(req *http.Request) (*http.Response, error) {
+			updated = true
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/resource/pathway_folder/Validate_Create/patch_folder.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://api.bland.ai/v1/pathway/folders/folder-1",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "bland_pathway_folder" "support" {
+						name = "Support"
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bland_pathway_folder.support", "id", "folder-1"),
+					resource.TestCheckResourceAttr("bland_pathway_folder.support", "name", "Support"),
+					resource.TestCheckNoResourceAttr("bland_pathway_folder.support", "parent_folder_id"),
+				),
+			},
+			{
+				Config: `
+					resource "bland_pathway_folder" "support" {
+						name             = "Customer Support"
+						parent_folder_id = "folder-0"
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bland_pathway_folder.support", "id", "folder-1"),
+					resource.TestCheckResourceAttr("bland_pathway_folder.support", "name", "Customer Support"),
+					resource.TestCheckResourceAttr("bland_pathway_folder.support", "parent_folder_id", "folder-0"),
+				),
+			},
+			{
+				ResourceName:      "bland_pathway_folder.support",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -4,10 +4,14 @@
 package pathways_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jameshiester/terraform-provider-bland/internal/mocks"
 	"github.com/jarcoal/httpmock"
 )
@@ -172,3 +176,151 @@ func TestUnitConversationalPathwayResource_Validate_Create(t *testing.T) {
 		},
 	})
 }
+
+func TestUnitConversationalPathwayResource_Validate_PostCallActions(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var createBody map[string]any
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/v1/pathway/create",
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&createBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(http.StatusCreated, httpmock.File("./tests/resource/pathway/Validate_Create/post_pathway.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://api.bland.ai/v1/pathway/123",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bland.ai/v1/pathway/123`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/resource/pathway/Validate_PostCallActions/get_pathway.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bland.ai/v1/pathway/123/versions`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/resource/pathway/Validate_PostCallActions/get_pathway_versions.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "bland_conversational_pathway" "path" {
+						name              = "TestPathwayName"
+						description       = "TestPathwayDescription"
+						post_call_actions = ["send_summary_email", "update_crm"]
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bland_conversational_pathway.path", "post_call_actions.#", "2"),
+					resource.TestCheckResourceAttr("bland_conversational_pathway.path", "post_call_actions.0", "send_summary_email"),
+					resource.TestCheckResourceAttr("bland_conversational_pathway.path", "post_call_actions.1", "update_crm"),
+					func(_ *terraform.State) error {
+						actions, ok := createBody["post_call_actions"].([]any)
+						if !ok || len(actions) != 2 {
+							return fmt.Errorf("expected post_call_actions to be sent on create, got %v", createBody["post_call_actions"])
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestUnitConversationalPathwayResource_Validate_Folder(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	folderID := "null"
+	deleted := false
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/v1/pathway/create",
+		func(req *http.Request) (*http.Response, error) {
+			folderID = "null"
+			return httpmock.NewStringResponse(http.StatusCreated, httpmock.File("./tests/resource/pathway/Validate_Create/post_pathway.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://api.bland.ai/v1/pathway/123",
+		func(req *http.Request) (*http.Response, error) {
+			deleted = true
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bland.ai/v1/pathway/123`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(`{"name": "TestPathwayName", "description": "TestPathwayDescription", "nodes": [], "edges": [], "folder_id": %s}`, folderID)), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bland.ai/v1/pathway/123/versions`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/resource/pathway/Validate_PostCallActions/get_pathway_versions.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/convo_pathway/update",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, `{}`), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/v1/pathway/folders/move",
+		func(req *http.Request) (*http.Response, error) {
+			var move map[string]any
+			if err := json.NewDecoder(req.Body).Decode(&move); err != nil {
+				return nil, err
+			}
+			switch move["folder_id"] {
+			case "missing":
+				return httpmock.NewStringResponse(http.StatusOK, `{"errors": [{"error": "FolderNotFound", "message": "Folder not found"}]}`), nil
+			case nil:
+				folderID = "null"
+			default:
+				folderID = fmt.Sprintf("%q", move["folder_id"])
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{"errors": null}`), nil
+		})
+
+	config := func(folderID string) string {
+		return fmt.Sprintf(`
+			resource "bland_conversational_pathway" "path" {
+				name        = "TestPathwayName"
+				description = "TestPathwayDescription"
+				folder_id   = %q
+			}
+		`, folderID)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The created pathway is kept in state when it cannot be moved, so it is replaced instead of duplicated.
+				Config:      config("missing"),
+				ExpectError: regexp.MustCompile("Folder not found"),
+			},
+			{
+				Config: config("folder-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bland_conversational_pathway.path", "folder_id", "folder-1"),
+					func(_ *terraform.State) error {
+						if !deleted {
+							return fmt.Errorf("expected the pathway that could not be moved to be replaced")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: config(""),
+				Check:  resource.TestCheckResourceAttr("bland_conversational_pathway.path", "folder_id", ""),
+			},
+		},
+	})
+}
@@ -0,0 +1,319 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jameshiester/terraform-provider-bland/internal/api"
+	utils "github.com/jameshiester/terraform-provider-bland/internal/util"
+)
+
+var _ resource.Resource = &PathwayTestResource{}
+
+type PathwayTestResource struct {
+	utils.TypeInfo
+	PathwayClient client
+}
+
+func NewPathwayTestResource() resource.Resource {
+	return &PathwayTestResource{
+		TypeInfo: utils.TypeInfo{
+			TypeName: "pathway_test",
+		},
+	}
+}
+
+func (r *PathwayTestResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *PathwayTestResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	_, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Runs a scripted conversation against a [Conversational Pathway](https://docs.bland.ai/tutorials/pathways) through the pathway chat API, without placing a call, " +
+			"and asserts the nodes visited, the variables extracted and the responses of the agent. The apply fails when an assertion fails, unless `fail_on_assertion` is `false`.\n\n" +
+			"The conversation runs when the resource is created and again whenever any of its arguments change. Use `triggers` to run it again when the pathway changes. " +
+			"Destroying this resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the chat the conversation ran in.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pathway_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the pathway to test.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version_number": schema.Int64Attribute{
+				MarkdownDescription: "Version of the pathway to test. Defaults to the current draft.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"start_node_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the node to start the conversation at. Defaults to the start node of the pathway.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"request_data": schema.MapAttribute{
+				MarkdownDescription: "Variables available to the pathway from the start of the conversation, like the `request_data` of a call.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that run the conversation again when they change, e.g. the `revision_number` of the pathway.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"fail_on_assertion": schema.BoolAttribute{
+				MarkdownDescription: "Whether a failed assertion fails the apply. When `false` failed assertions are reported as warnings and recorded in `failures`, which can be asserted in a `check` block. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"turns": schema.ListNestedAttribute{
+				MarkdownDescription: "User messages sent one after the other, with the assertions checked after the agent responded to each.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Message of the user.",
+							Required:            true,
+						},
+						"expect_node": schema.StringAttribute{
+							MarkdownDescription: "Id or name of the node the conversation is expected to be at after the turn.",
+							Optional:            true,
+						},
+						"expect_response_contains": schema.ListAttribute{
+							MarkdownDescription: "Texts the response of the agent is expected to contain.",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+						"expect_variables": schema.MapAttribute{
+							MarkdownDescription: "Variables expected to be extracted after the turn. Values that are not strings are compared JSON encoded, e.g. `42` or `true`.",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				MarkdownDescription: "Response of the agent to each turn.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Message of the user.",
+							Computed:            true,
+						},
+						"responses": schema.ListAttribute{
+							MarkdownDescription: "Responses of the agent.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"node_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the node the conversation was at after the turn.",
+							Computed:            true,
+						},
+						"node_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the node the conversation was at after the turn.",
+							Computed:            true,
+						},
+						"variables": schema.MapAttribute{
+							MarkdownDescription: "Variables extracted so far. Values that are not strings are JSON encoded.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"failures": schema.ListAttribute{
+				MarkdownDescription: "Assertions that failed. Always empty when `fail_on_assertion` is `true`, as the apply fails instead.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *PathwayTestResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	_, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.PathwayClient = newPathwayClient(client.Api)
+}
+
+func (r *PathwayTestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan PathwayTestModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	chatID, results, failures, err := r.run(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when running %s", r.FullTypeName()), err.Error())
+		return
+	}
+	if len(failures) > 0 {
+		summary := fmt.Sprintf("Pathway test of %s failed", plan.PathwayID.ValueString())
+		detail := "Failed assertions:\n  - " + strings.Join(failures, "\n  - ")
+		if plan.FailOnAssertion.ValueBool() {
+			resp.Diagnostics.AddError(summary, detail)
+			return
+		}
+		resp.Diagnostics.AddWarning(summary, detail)
+	}
+
+	plan.ID = types.StringValue(chatID)
+	resultList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: pathwayTestResultAttributeTypes()}, results)
+	resp.Diagnostics.Append(diags...)
+	plan.Results = resultList
+	failureList, diags := types.ListValueFrom(ctx, types.StringType, failures)
+	resp.Diagnostics.Append(diags...)
+	plan.Failures = failureList
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PathwayTestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// The results of a conversation do not change after it ran, so there is nothing to refresh.
+	tflog.Debug(ctx, fmt.Sprintf("Keeping the results of %s from the last run", r.FullTypeName()))
+}
+
+func (r *PathwayTestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Every argument forces a new run, so updates only carry the results of the last run over.
+	var plan, state PathwayTestModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = state.ID
+	plan.Results = state.Results
+	plan.Failures = state.Failures
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PathwayTestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Pathway chats cannot be deleted, the test is only forgotten.
+	tflog.Debug(ctx, fmt.Sprintf("Removing %s from state", r.FullTypeName()))
+}
+
+// run sends the scripted turns to a new chat with the pathway and checks the assertions of each turn.
+func (r *PathwayTestResource) run(ctx context.Context, plan PathwayTestModel) (string, []PathwayTestResultModel, []string, error) {
+	chat := createPathwayChatDto{
+		PathwayID:   plan.PathwayID.ValueString(),
+		StartNodeID: plan.StartNodeID.ValueString(),
+	}
+	if !plan.VersionNumber.IsNull() {
+		versionNumber := int(plan.VersionNumber.ValueInt64())
+		chat.VersionNumber = &versionNumber
+	}
+	if len(plan.RequestData) > 0 {
+		chat.RequestData = make(map[string]string, len(plan.RequestData))
+		for name, value := range plan.RequestData {
+			chat.RequestData[name] = value.ValueString()
+		}
+	}
+
+	chatID, err := r.PathwayClient.CreatePathwayChat(ctx, chat)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	results := make([]PathwayTestResultModel, 0, len(plan.Turns))
+	failures := make([]string, 0)
+	for i, turn := range plan.Turns {
+		if err := utils.CheckContextTimeout(ctx, fmt.Sprintf("turn %d of the pathway test", i+1)); err != nil {
+			return "", nil, nil, err
+		}
+		response, err := r.PathwayClient.SendPathwayChatMessage(ctx, chatID, turn.Message.ValueString())
+		if err != nil {
+			return "", nil, nil, fmt.Errorf("turn %d: %w", i+1, err)
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Pathway test turn %d is at node %s", i+1, describeChatNode(*response)))
+		results = append(results, ConvertFromPathwayChatDto(turn.Message.ValueString(), *response))
+		failures = append(failures, CheckPathwayTestTurn(i, turn, *response)...)
+	}
+	return chatID, results, failures, nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathways_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jameshiester/terraform-provider-bland/internal/mocks"
+	"github.com/jarcoal/httpmock"
+)
+
+// registerPathwayChatResponders fakes the pathway chat endpoint, answering each message with the next turn fixture.
+func registerPathwayChatResponders() {
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/v1/pathway/chat/create",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/resource/pathway_test/Validate_Create/create_chat.json").String()), nil
+		})
+
+	turn := 0
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/v1/pathway/chat/chat-123",
+		func(req *http.Request) (*http.Response, error) {
+			turn++
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File(fmt.Sprintf("./tests/resource/pathway_test/Validate_Create/chat_turn_%d.json", turn)).String()), nil
+		})
+}
+
+func TestUnitPathwayTestResource_Validate_Create(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerPathwayChatResponders()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "bland_pathway_test" "order_status" {
+						pathway_id = "123"
+						request_data = {
+							customer_name = "Jane"
+						}
+						turns = [
+							{
+								message                  = "Hello"
+								expect_node              = "Start"
+								expect_response_contains = ["order number"]
+							},
+							{
+								message     = "It is 4521"
+								expect_node = "2"
+								expect_variables = {
+									order_id = "4521"
+								}
+							}
+						]
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bland_pathway_test.order_status", "id", "chat-123"),
+					resource.TestCheckResourceAttr("bland_pathway_test.order_status", "fail_on_assertion", "true"),
+					resource.TestCheckResourceAttr("bland_pathway_test.order_status", "failures.#", "0"),
+					resource.TestCheckResourceAttr("bland_pathway_test.order_status", "results.#", "2"),
+					resource.TestCheckResourceAttr("bland_pathway_test.order_status", "results.1.node_name", "Lookup Order"),
+					resource.TestCheckResourceAttr("bland_pathway_test.order_status", "results.1.responses.1", "Your order has shipped."),
+					resource.TestCheckResourceAttr("bland_pathway_test.order_status", "results.1.variables.order_id", "4521"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitPathwayTestResource_Validate_Failed_Assertion(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerPathwayChatResponders()
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "bland_pathway_test" "order_status" {
+						pathway_id = "123"
+						turns = [
+							{
+								message     = "Hello"
+								expect_node = "Lookup Order"
+							}
+						]
+					}
+					`,
+				ExpectError: regexp.MustCompile(`expected node 'Lookup Order', got 'Start' \(1\)`),
+			},
+		},
+	})
+}
@@ -4,8 +4,18 @@
 package knowledgebase
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
 	"os"
+	"path/filepath"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -16,10 +26,34 @@ func ConvertFromKnowledgeBaseDto(dto KnowledgeBaseDto) KnowledgeBaseModel {
 		Description:   types.StringValue(dto.Description),
 		Text:          types.StringValue(dto.Text),
 		ExtractedText: types.StringPointerValue(dto.ExtractedText),
+		Status:        types.StringValue(knowledgeBaseStatus(dto.Status)),
+		Documents:     ConvertFromKnowledgeBaseDocumentDtos(dto.Documents),
 		FilePath:      types.StringNull(), // Not returned from API
+		SourceSHA256:  types.StringNull(),
 	}
 }
 
+var knowledgeBaseDocumentType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"url":   types.StringType,
+	"title": types.StringType,
+}}
+
+func ConvertFromKnowledgeBaseDocumentDtos(dtos []KnowledgeBaseDocumentDto) types.List {
+	if dtos == nil {
+		return types.ListNull(knowledgeBaseDocumentType)
+	}
+	documents := make([]attr.Value, 0, len(dtos))
+	for _, dto := range dtos {
+		document, _ := types.ObjectValue(knowledgeBaseDocumentType.AttrTypes, map[string]attr.Value{
+			"url":   types.StringValue(dto.URL),
+			"title": types.StringValue(dto.Title),
+		})
+		documents = append(documents, document)
+	}
+	list, _ := types.ListValue(knowledgeBaseDocumentType, documents)
+	return list
+}
+
 func ConvertFromKnowledgeBaseDtoToDataSource(dto KnowledgeBaseDto) KnowledgeBaseDataSourceModel {
 	return KnowledgeBaseDataSourceModel{
 		ID:            types.StringValue(dto.ID),
@@ -29,40 +63,116 @@ func ConvertFromKnowledgeBaseDtoToDataSource(dto KnowledgeBaseDto) KnowledgeBase
 	}
 }
 
-func ConvertToCreateKnowledgeBaseDto(model KnowledgeBaseModel) (CreateKnowledgeBaseDto, error) {
-	var fileBytesPtr *[]byte
+func ConvertToCreateKnowledgeBaseDto(ctx context.Context, model KnowledgeBaseModel) (CreateKnowledgeBaseDto, error) {
+	var filePath *string
 	if !model.FilePath.IsNull() && model.FilePath.ValueString() != "" {
-		fileBytes, err := os.ReadFile(model.FilePath.ValueString())
-		if err != nil {
-			return CreateKnowledgeBaseDto{}, err
-		}
-		fileBytesPtr = &fileBytes
-	} else {
-		fileBytesPtr = nil
+		filePath = model.FilePath.ValueStringPointer()
+	}
+	webSource, err := ConvertToWebSourceDto(ctx, model.WebSource)
+	if err != nil {
+		return CreateKnowledgeBaseDto{}, err
 	}
 	return CreateKnowledgeBaseDto{
 		Name:        model.Name.ValueString(),
 		Description: model.Description.ValueString(),
-		File:        fileBytesPtr,
+		FilePath:    filePath,
+		WebSource:   webSource,
 		Text:        model.Text.ValueStringPointer(),
 	}, nil
 }
 
-func ConvertToUpdateKnowledgeBaseDto(model KnowledgeBaseModel) (UpdateKnowledgeBaseDto, error) {
-	var fileBytesPtr *[]byte
-	if !model.FilePath.IsNull() && model.FilePath.ValueString() != "" {
-		fileBytes, err := os.ReadFile(model.FilePath.ValueString())
-		if err != nil {
-			return UpdateKnowledgeBaseDto{}, err
+func ConvertToWebSourceDto(ctx context.Context, model *WebSourceModel) (*WebSourceDto, error) {
+	if model == nil {
+		return nil, nil
+	}
+	dto := WebSourceDto{
+		SitemapURL: model.SitemapURL.ValueStringPointer(),
+		MaxDepth:   model.MaxDepth.ValueInt32(),
+		MaxPages:   model.MaxPages.ValueInt32Pointer(),
+	}
+	if !model.URLs.IsNull() {
+		diags := model.URLs.ElementsAs(ctx, &dto.URLs, false)
+		if diags.HasError() {
+			return nil, errors.New("urls are not valid")
 		}
-		fileBytesPtr = &fileBytes
-	} else {
-		fileBytesPtr = nil
 	}
+	return &dto, nil
+}
+
+func ConvertToUpdateKnowledgeBaseDto(model KnowledgeBaseModel) UpdateKnowledgeBaseDto {
 	return UpdateKnowledgeBaseDto{
 		Name:        model.Name.ValueString(),
 		Description: model.Description.ValueString(),
-		File:        fileBytesPtr,
 		Text:        model.Text.ValueStringPointer(),
-	}, nil
+	}
+}
+
+// fileSHA256 returns the hex encoded SHA-256 digest of the content of a file.
+func fileSHA256(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// webSourceSHA256 returns the hex encoded SHA-256 digest of the pages requested from Bland, the pages are scraped
+// again whenever it changes.
+func webSourceSHA256(webSource WebSourceDto) (string, error) {
+	content, err := json.Marshal(webSource)
+	if err != nil {
+		return "", err
+	}
+	digest := sha256.Sum256(content)
+	return hex.EncodeToString(digest[:]), nil
+}
+
+// knowledgeBaseContentTypes maps the extensions of the documents Bland extracts text from to their content type.
+var knowledgeBaseContentTypes = map[string]string{
+	".csv":  "text/csv",
+	".doc":  "application/msword",
+	".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".htm":  "text/html",
+	".html": "text/html",
+	".json": "application/json",
+	".md":   "text/markdown",
+	".pdf":  "application/pdf",
+	".txt":  "text/plain",
+}
+
+// knowledgeBaseContentType returns the content type of a knowledge base file from its extension.
+func knowledgeBaseContentType(path string) string {
+	extension := strings.ToLower(filepath.Ext(path))
+	if contentType, ok := knowledgeBaseContentTypes[extension]; ok {
+		return contentType
+	}
+	if contentType := mime.TypeByExtension(extension); contentType != "" {
+		return contentType
+	}
+	return "application/octet-stream"
+}
+
+// knowledgeBaseStatus returns the upper case ingestion status of a knowledge base. Knowledge bases Bland reports no
+// status for are ready.
+func knowledgeBaseStatus(status *string) string {
+	if status == nil || *status == "" {
+		return "COMPLETED"
+	}
+	return strings.ToUpper(*status)
+}
+
+// knowledgeBaseReady reports whether Bland finished ingesting a knowledge base with the given status.
+func knowledgeBaseReady(status string) bool {
+	return status == "COMPLETED" || status == "READY"
+}
+
+// knowledgeBaseFailed reports whether Bland could not ingest a knowledge base with the given status.
+func knowledgeBaseFailed(status string) bool {
+	return status == "FAILED" || status == "ERROR"
 }
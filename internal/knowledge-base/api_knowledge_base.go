@@ -4,19 +4,32 @@
 package knowledgebase
 
 import (
-	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"net/url"
+	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jameshiester/terraform-provider-bland/internal/api"
 	"github.com/jameshiester/terraform-provider-bland/internal/constants"
+	utils "github.com/jameshiester/terraform-provider-bland/internal/util"
 )
 
+// MaxKnowledgeBaseFileSize is the size of the largest file the provider uploads as a knowledge base, in bytes. The limit
+// is enforced by the provider to fail before streaming very large files, it is not a limit documented by Bland.
+const MaxKnowledgeBaseFileSize int64 = 50 << 20
+
+// knowledgeBasePollInterval is the time waited between two reads of a knowledge base being ingested.
+const knowledgeBasePollInterval = 5 * time.Second
+
 type KnowledgeBaseClient struct {
 	Api *api.Client
 }
@@ -26,59 +39,34 @@ func NewKnowledgeBaseClient(apiClient *api.Client) *KnowledgeBaseClient {
 }
 
 func (c *KnowledgeBaseClient) CreateKnowledgeBase(ctx context.Context, kbModel KnowledgeBaseModel) (*string, error) {
-	createDto, err := ConvertToCreateKnowledgeBaseDto(kbModel)
+	createDto, err := ConvertToCreateKnowledgeBaseDto(ctx, kbModel)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read file for knowledge base: %w", err)
+		return nil, fmt.Errorf("failed to create knowledge base: %w", err)
 	}
 
 	var created createKnowledgeBaseUploadResponseDto
-	if createDto.File != nil {
+	switch {
+	case createDto.FilePath != nil:
+		err := c.uploadKnowledgeBase(ctx, createDto, &created)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create knowledge base: %w", err)
+		}
+	case createDto.WebSource != nil:
 		apiUrl := &url.URL{
 			Scheme: constants.HTTPS,
 			Host:   c.Api.Config.BaseURL,
-			Path:   "/v1/knowledgebases/upload",
+			Path:   "/v1/knowledgebases/scrape",
 		}
-
-		// Create multipart form
-		var buf bytes.Buffer
-		writer := multipart.NewWriter(&buf)
-
-		// Add text fields
-		err := writer.WriteField("name", createDto.Name)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create form file: %w", err)
+		scrapeDto := scrapeKnowledgeBaseDto{
+			Name:         createDto.Name,
+			Description:  createDto.Description,
+			WebSourceDto: *createDto.WebSource,
 		}
-		err = writer.WriteField("description", createDto.Description)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create form file: %w", err)
-		}
-
-		// Add file
-		if len(*createDto.File) > 0 {
-			filename := filepath.Base(kbModel.FilePath.ValueString())
-			part, err := writer.CreateFormFile("file", filename)
-			if err != nil {
-				return nil, fmt.Errorf("failed to create form file: %w", err)
-			}
-			_, err = part.Write(*createDto.File)
-			if err != nil {
-				return nil, fmt.Errorf("failed to write file data: %w", err)
-			}
-		}
-
-		writer.Close()
-
-		// Set content type header
-		headers := http.Header{}
-		headers.Set("Content-Type", writer.FormDataContentType())
-		reqBody := bytes.NewReader(buf.Bytes())
-		ctxWithTimeout, cancel := context.WithTimeout(ctx, 60*time.Second)
-		defer cancel()
-		_, err = c.Api.ExecuteMultipart(ctxWithTimeout, "POST", apiUrl.String(), headers, reqBody, []int{http.StatusOK}, &created)
+		_, err := c.Api.Execute(ctx, nil, "POST", apiUrl.String(), nil, scrapeDto, []int{http.StatusOK}, &created)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create knowledge base: %w", err)
 		}
-	} else {
+	default:
 		apiUrl := &url.URL{
 			Scheme: constants.HTTPS,
 			Host:   c.Api.Config.BaseURL,
@@ -89,10 +77,113 @@ func (c *KnowledgeBaseClient) CreateKnowledgeBase(ctx context.Context, kbModel K
 			return nil, fmt.Errorf("failed to create knowledge base: %w", err)
 		}
 	}
+	if created.Data.ID == "" {
+		return nil, errors.New("failed to create knowledge base: no knowledge base id returned")
+	}
 
 	return &created.Data.ID, nil
 }
 
+// uploadKnowledgeBase streams the multipart form creating a knowledge base from a file, the file is never held in memory.
+func (c *KnowledgeBaseClient) uploadKnowledgeBase(ctx context.Context, createDto CreateKnowledgeBaseDto, created *createKnowledgeBaseUploadResponseDto) error {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   c.Api.Config.BaseURL,
+		Path:   "/v1/knowledgebases/upload",
+	}
+
+	file, err := os.Open(*createDto.FilePath)
+	if err != nil {
+		return fmt.Errorf("failed to open file for knowledge base: %w", err)
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to open file for knowledge base: %w", err)
+	}
+	if err := checkKnowledgeBaseFileSize(info); err != nil {
+		return err
+	}
+
+	pipeReader, pipeWriter := io.Pipe()
+	writer := multipart.NewWriter(pipeWriter)
+	progress := &uploadProgressReader{ctx: ctx, reader: file, name: info.Name(), size: info.Size()}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		pipeWriter.CloseWithError(writeKnowledgeBaseForm(writer, createDto, progress))
+	}()
+
+	// Set content type header
+	headers := http.Header{}
+	headers.Set("Content-Type", writer.FormDataContentType())
+	tflog.Info(ctx, fmt.Sprintf("Uploading %s (%d bytes) to knowledge base '%s'", info.Name(), info.Size(), createDto.Name))
+	_, err = c.Api.ExecuteMultipart(ctx, "POST", apiUrl.String(), headers, pipeReader, []int{http.StatusOK}, created)
+	// Stop the writer if the request ended before the whole form was sent.
+	pipeReader.CloseWithError(io.ErrClosedPipe)
+	<-done
+	return err
+}
+
+// writeKnowledgeBaseForm writes the fields and the file of a knowledge base upload and closes the form.
+func writeKnowledgeBaseForm(writer *multipart.Writer, createDto CreateKnowledgeBaseDto, file io.Reader) error {
+	err := writer.WriteField("name", createDto.Name)
+	if err != nil {
+		return fmt.Errorf("failed to create form field: %w", err)
+	}
+	err = writer.WriteField("description", createDto.Description)
+	if err != nil {
+		return fmt.Errorf("failed to create form field: %w", err)
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, quoteEscaper.Replace(filepath.Base(*createDto.FilePath))))
+	header.Set("Content-Type", knowledgeBaseContentType(*createDto.FilePath))
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return fmt.Errorf("failed to create form file: %w", err)
+	}
+	_, err = io.Copy(part, file)
+	if err != nil {
+		return fmt.Errorf("failed to write file data: %w", err)
+	}
+	return writer.Close()
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// checkKnowledgeBaseFileSize rejects directories and files larger than MaxKnowledgeBaseFileSize.
+func checkKnowledgeBaseFileSize(info os.FileInfo) error {
+	if info.IsDir() {
+		return fmt.Errorf("%s is a directory", info.Name())
+	}
+	if info.Size() > MaxKnowledgeBaseFileSize {
+		return fmt.Errorf("%s is %d bytes, knowledge base files are limited to %d bytes", info.Name(), info.Size(), MaxKnowledgeBaseFileSize)
+	}
+	return nil
+}
+
+// uploadProgressReader logs the progress of a file upload every 10 percent.
+type uploadProgressReader struct {
+	ctx    context.Context
+	reader io.Reader
+	name   string
+	size   int64
+	read   int64
+}
+
+func (r *uploadProgressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if r.size > 0 && n > 0 {
+		before := r.read * 10 / r.size
+		r.read += int64(n)
+		if after := r.read * 10 / r.size; after > before {
+			tflog.Info(r.ctx, fmt.Sprintf("Uploaded %d%% of %s (%d/%d bytes)", after*10, r.name, r.read, r.size))
+		}
+	}
+	return n, err
+}
+
 func (c *KnowledgeBaseClient) ReadKnowledgeBase(ctx context.Context, id string) (*KnowledgeBaseDto, error) {
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
@@ -105,6 +196,10 @@ func (c *KnowledgeBaseClient) ReadKnowledgeBase(ctx context.Context, id string)
 	var kb readKnowledgeBaseResponseDto
 	_, err := c.Api.Execute(ctx, nil, "GET", apiUrl.String(), headers, nil, []int{http.StatusOK}, &kb)
 	if err != nil {
+		var httpErr api.UnexpectedHttpStatusCodeError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return nil, api.WrapIntoProviderError(err, api.ErrorCode(constants.ERROR_OBJECT_NOT_FOUND), fmt.Sprintf("Knowledge base '%s' not found", id))
+		}
 		return nil, fmt.Errorf("failed to read knowledge base: %w", err)
 	}
 	result := KnowledgeBaseDto{
@@ -112,22 +207,50 @@ func (c *KnowledgeBaseClient) ReadKnowledgeBase(ctx context.Context, id string)
 		Name:          kb.Data.Name,
 		Description:   kb.Data.Description,
 		ExtractedText: kb.Data.ExtractedText,
+		Status:        kb.Data.Status,
+		Documents:     kb.Data.Documents,
 	}
 	return &result, nil
 }
 
-func (c *KnowledgeBaseClient) UpdateKnowledgeBase(ctx context.Context, id string, kbModel KnowledgeBaseModel) (*KnowledgeBaseDto, error) {
-	updateDto, err := ConvertToUpdateKnowledgeBaseDto(kbModel)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read file for knowledge base: %w", err)
+// WaitForKnowledgeBase polls a knowledge base until Bland finished ingesting its content. The knowledge base is
+// returned along with the error when ingestion failed or the context expired, so its last status can be saved.
+func (c *KnowledgeBaseClient) WaitForKnowledgeBase(ctx context.Context, id string) (*KnowledgeBaseDto, error) {
+	for {
+		kb, err := c.ReadKnowledgeBase(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		status := knowledgeBaseStatus(kb.Status)
+		switch {
+		case knowledgeBaseReady(status):
+			return kb, nil
+		case knowledgeBaseFailed(status):
+			return kb, fmt.Errorf("ingestion of knowledge base '%s' failed with status %s", id, status)
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Knowledge base '%s' is %s, waiting for ingestion to finish", id, status))
+
+		err = c.Api.SleepWithContext(ctx, knowledgeBasePollInterval)
+		if err == nil {
+			err = utils.CheckContextTimeout(ctx, fmt.Sprintf("ingestion of knowledge base '%s'", id))
+		}
+		if err != nil {
+			return kb, fmt.Errorf("knowledge base '%s' is still %s: %w", id, status, err)
+		}
 	}
+}
+
+// UpdateKnowledgeBase updates the name, description and text of a knowledge base. The PATCH endpoint does not accept
+// files, use CreateKnowledgeBase to upload a new version of a file.
+func (c *KnowledgeBaseClient) UpdateKnowledgeBase(ctx context.Context, id string, kbModel KnowledgeBaseModel) (*KnowledgeBaseDto, error) {
+	updateDto := ConvertToUpdateKnowledgeBaseDto(kbModel)
 	var updated createKnowledgeBaseUploadResponseDto
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   c.Api.Config.BaseURL,
 		Path:   fmt.Sprintf("/v1/knowledgebases/%s", id),
 	}
-	_, err = c.Api.Execute(ctx, nil, "PATCH", apiUrl.String(), nil, updateDto, []int{http.StatusOK}, &updated)
+	_, err := c.Api.Execute(ctx, nil, "PATCH", apiUrl.String(), nil, updateDto, []int{http.StatusOK}, &updated)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update knowledge base: %w", err)
 	}
@@ -135,7 +258,6 @@ func (c *KnowledgeBaseClient) UpdateKnowledgeBase(ctx context.Context, id string
 		ID:          id,
 		Name:        kbModel.Name.ValueString(),
 		Description: kbModel.Description.ValueString(),
-		File:        updateDto.File,
 	}
 	return &updatedDto, nil
 }
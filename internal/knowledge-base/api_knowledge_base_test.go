@@ -5,9 +5,14 @@ package knowledgebase_test
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/jameshiester/terraform-provider-bland/internal/api"
 	"github.com/jameshiester/terraform-provider-bland/internal/config"
@@ -76,6 +81,49 @@ func TestKnowledgeBaseClient_CreateKnowledgeBase_TextOnly(t *testing.T) {
 	require.Equal(t, "kb_456", *result)
 }
 
+func TestKnowledgeBaseClient_CreateKnowledgeBase_WebSource(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var body map[string]any
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/v1/knowledgebases/scrape",
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(200, `{"data":{"vector_id":"kb_789"}}`), nil
+		})
+
+	providerConfig := &config.ProviderConfig{
+		BaseURL: "api.bland.ai",
+		APIKey:  "123",
+	}
+	apiClient := api.NewApiClientBase(providerConfig, api.NewAuthBase(providerConfig))
+	client := knowledgebase.NewKnowledgeBaseClient(apiClient)
+
+	model := knowledgebase.KnowledgeBaseModel{
+		Name:        types.StringValue("Help center"),
+		Description: types.StringValue("Test Description"),
+		WebSource: &knowledgebase.WebSourceModel{
+			URLs:       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("https://help.example.com")}),
+			SitemapURL: types.StringNull(),
+			MaxDepth:   types.Int32Value(2),
+			MaxPages:   types.Int32Value(50),
+		},
+	}
+
+	result, err := client.CreateKnowledgeBase(context.Background(), model)
+	require.NoError(t, err)
+	require.Equal(t, "kb_789", *result)
+	require.Equal(t, map[string]any{
+		"name":        "Help center",
+		"description": "Test Description",
+		"urls":        []any{"https://help.example.com"},
+		"max_depth":   float64(2),
+		"max_pages":   float64(50),
+	}, body)
+}
+
 func TestKnowledgeBaseClient_ReadKnowledgeBase(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -178,3 +226,213 @@ func TestKnowledgeBaseClient_DeleteKnowledgeBase(t *testing.T) {
 		t.Fatalf("Expected no error, got %v", err)
 	}
 }
+
+func TestKnowledgeBaseClient_ReadKnowledgeBase_NotFound(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://api.bland.ai/v1/knowledgebases/kb_123",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"errors": [{"error": "NOT_FOUND", "message": "Knowledge base not found"}]}`),
+	)
+
+	providerConfig := &config.ProviderConfig{
+		BaseURL: "api.bland.ai",
+		APIKey:  "123",
+	}
+	apiClient := api.NewApiClientBase(providerConfig, api.NewAuthBase(providerConfig))
+	client := knowledgebase.NewKnowledgeBaseClient(apiClient)
+
+	_, err := client.ReadKnowledgeBase(context.Background(), "kb_123")
+	require.ErrorIs(t, err, api.ErrObjectNotFound)
+}
+
+func TestKnowledgeBaseClient_CreateKnowledgeBase_StreamsFile(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	fields := map[string]string{}
+	var fileName, contentType string
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/v1/knowledgebases/upload",
+		func(req *http.Request) (*http.Response, error) {
+			reader, err := req.MultipartReader()
+			if err != nil {
+				return nil, err
+			}
+			for {
+				part, err := reader.NextPart()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					return nil, err
+				}
+				content, err := io.ReadAll(part)
+				if err != nil {
+					return nil, err
+				}
+				if part.FormName() == "file" {
+					fileName = part.FileName()
+					contentType = part.Header.Get("Content-Type")
+				}
+				fields[part.FormName()] = string(content)
+			}
+			return httpmock.NewStringResponse(200, `{"data":{"vector_id":"kb_123"}}`), nil
+		})
+
+	providerConfig := &config.ProviderConfig{
+		BaseURL: "api.bland.ai",
+		APIKey:  "123",
+	}
+	apiClient := api.NewApiClientBase(providerConfig, api.NewAuthBase(providerConfig))
+	client := knowledgebase.NewKnowledgeBaseClient(apiClient)
+
+	model := knowledgebase.KnowledgeBaseModel{
+		Name:        types.StringValue("Test KB"),
+		Description: types.StringValue("Test Description"),
+		FilePath:    types.StringValue("./tests/example.txt"),
+	}
+
+	result, err := client.CreateKnowledgeBase(context.Background(), model)
+	require.NoError(t, err)
+	require.Equal(t, "kb_123", *result)
+
+	expected, err := os.ReadFile("./tests/example.txt")
+	require.NoError(t, err)
+	require.Equal(t, "Test KB", fields["name"])
+	require.Equal(t, "Test Description", fields["description"])
+	require.Equal(t, string(expected), fields["file"])
+	require.Equal(t, "example.txt", fileName)
+	require.Equal(t, "text/plain", contentType)
+}
+
+func TestKnowledgeBaseClient_CreateKnowledgeBase_FileTooLarge(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "manual.pdf")
+	require.NoError(t, os.WriteFile(filePath, nil, 0o600))
+	require.NoError(t, os.Truncate(filePath, knowledgebase.MaxKnowledgeBaseFileSize+1))
+
+	providerConfig := &config.ProviderConfig{
+		BaseURL: "api.bland.ai",
+		APIKey:  "123",
+	}
+	apiClient := api.NewApiClientBase(providerConfig, api.NewAuthBase(providerConfig))
+	client := knowledgebase.NewKnowledgeBaseClient(apiClient)
+
+	model := knowledgebase.KnowledgeBaseModel{
+		Name:        types.StringValue("Test KB"),
+		Description: types.StringValue("Test Description"),
+		FilePath:    types.StringValue(filePath),
+	}
+
+	_, err := client.CreateKnowledgeBase(context.Background(), model)
+	require.ErrorContains(t, err, "knowledge base files are limited to")
+}
+
+func TestKnowledgeBaseClient_CreateKnowledgeBase_UploadServerError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/v1/knowledgebases/upload",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusInternalServerError, `{"errors":[{"message":"upload failed"}]}`), nil
+		})
+
+	providerConfig := &config.ProviderConfig{
+		BaseURL: "api.bland.ai",
+		APIKey:  "123",
+	}
+	apiClient := api.NewApiClientBase(providerConfig, api.NewAuthBase(providerConfig))
+	client := knowledgebase.NewKnowledgeBaseClient(apiClient)
+
+	model := knowledgebase.KnowledgeBaseModel{
+		Name:        types.StringValue("Test KB"),
+		Description: types.StringValue("Test Description"),
+		FilePath:    types.StringValue("./tests/example.txt"),
+	}
+
+	result, err := client.CreateKnowledgeBase(context.Background(), model)
+	require.ErrorAs(t, err, &api.UnexpectedHttpStatusCodeError{})
+	require.Nil(t, result)
+	require.Equal(t, 1, httpmock.GetTotalCallCount(), "a streamed upload cannot be sent again")
+}
+
+func TestKnowledgeBaseClient_CreateKnowledgeBase_NoID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/v1/knowledgebases/scrape",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(200, `{"data":{}}`), nil
+		})
+
+	providerConfig := &config.ProviderConfig{
+		BaseURL: "api.bland.ai",
+		APIKey:  "123",
+	}
+	apiClient := api.NewApiClientBase(providerConfig, api.NewAuthBase(providerConfig))
+	client := knowledgebase.NewKnowledgeBaseClient(apiClient)
+
+	model := knowledgebase.KnowledgeBaseModel{
+		Name:        types.StringValue("Help center"),
+		Description: types.StringValue("Test Description"),
+		WebSource: &knowledgebase.WebSourceModel{
+			URLs:       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("https://help.example.com")}),
+			SitemapURL: types.StringNull(),
+			MaxDepth:   types.Int32Value(0),
+			MaxPages:   types.Int32Null(),
+		},
+	}
+
+	result, err := client.CreateKnowledgeBase(context.Background(), model)
+	require.ErrorContains(t, err, "no knowledge base id returned")
+	require.Nil(t, result)
+}
+
+func TestKnowledgeBaseClient_WaitForKnowledgeBase(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://api.bland.ai/v1/knowledgebases/kb_123",
+		httpmock.ResponderFromMultipleResponses([]*http.Response{
+			httpmock.NewStringResponse(http.StatusOK, `{"data": {"name": "Test KB", "description": "Test Description", "status": "PROCESSING"}}`),
+			httpmock.NewStringResponse(http.StatusOK, `{"data": {"name": "Test KB", "description": "Test Description", "status": "processing"}}`),
+			httpmock.NewStringResponse(http.StatusOK, `{"data": {"name": "Test KB", "description": "Test Description", "text": "Extracted text content", "status": "COMPLETED"}}`),
+		}))
+
+	providerConfig := &config.ProviderConfig{
+		BaseURL:  "api.bland.ai",
+		APIKey:   "123",
+		TestMode: true,
+	}
+	apiClient := api.NewApiClientBase(providerConfig, api.NewAuthBase(providerConfig))
+	client := knowledgebase.NewKnowledgeBaseClient(apiClient)
+
+	result, err := client.WaitForKnowledgeBase(context.Background(), "kb_123")
+	require.NoError(t, err)
+	require.Equal(t, "COMPLETED", *result.Status)
+	require.Equal(t, "Extracted text content", *result.ExtractedText)
+	require.Equal(t, 3, httpmock.GetTotalCallCount())
+}
+
+func TestKnowledgeBaseClient_WaitForKnowledgeBase_Failed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://api.bland.ai/v1/knowledgebases/kb_123",
+		httpmock.ResponderFromMultipleResponses([]*http.Response{
+			httpmock.NewStringResponse(http.StatusOK, `{"data": {"name": "Test KB", "description": "Test Description", "status": "PROCESSING"}}`),
+			httpmock.NewStringResponse(http.StatusOK, `{"data": {"name": "Test KB", "description": "Test Description", "status": "FAILED"}}`),
+		}))
+
+	providerConfig := &config.ProviderConfig{
+		BaseURL:  "api.bland.ai",
+		APIKey:   "123",
+		TestMode: true,
+	}
+	apiClient := api.NewApiClientBase(providerConfig, api.NewAuthBase(providerConfig))
+	client := knowledgebase.NewKnowledgeBaseClient(apiClient)
+
+	result, err := client.WaitForKnowledgeBase(context.Background(), "kb_123")
+	require.ErrorContains(t, err, "ingestion of knowledge base 'kb_123' failed with status FAILED")
+	require.NotNil(t, result)
+	require.Equal(t, "FAILED", *result.Status)
+}
@@ -4,18 +4,27 @@
 package knowledgebase
 
 type KnowledgeBaseDto struct {
-	ID            string  `json:"id,omitempty"`
-	Name          string  `json:"name"`
-	Description   string  `json:"description"`
-	Text          string  `json:"text"`
-	ExtractedText *string `json:"-"` // not included in response
-	File          *[]byte `json:"-"` // Binary data, not serialized to JSON
+	ID            string                     `json:"id,omitempty"`
+	Name          string                     `json:"name"`
+	Description   string                     `json:"description"`
+	Text          string                     `json:"text"`
+	ExtractedText *string                    `json:"-"` // not included in response
+	Status        *string                    `json:"-"` // not included in response
+	Documents     []KnowledgeBaseDocumentDto `json:"-"` // not included in response
+	File          *[]byte                    `json:"-"` // Binary data, not serialized to JSON
+}
+
+type KnowledgeBaseDocumentDto struct {
+	URL   string `json:"url"`
+	Title string `json:"title"`
 }
 
 type readKnowledgeBaseResponseDataDto struct {
-	Name          string  `json:"name"`
-	Description   string  `json:"description"`
-	ExtractedText *string `json:"text"`
+	Name          string                     `json:"name"`
+	Description   string                     `json:"description"`
+	ExtractedText *string                    `json:"text"`
+	Status        *string                    `json:"status"`
+	Documents     []KnowledgeBaseDocumentDto `json:"documents"`
 }
 
 type readKnowledgeBaseResponseDto struct {
@@ -23,10 +32,24 @@ type readKnowledgeBaseResponseDto struct {
 }
 
 type CreateKnowledgeBaseDto struct {
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	Text        *string `json:"text,omitempty"`
-	File        *[]byte `json:"-"` // Binary data for multipart form
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	Text        *string       `json:"text,omitempty"`
+	FilePath    *string       `json:"-"` // File streamed as multipart form
+	WebSource   *WebSourceDto `json:"-"` // Pages scraped by Bland
+}
+
+type WebSourceDto struct {
+	URLs       []string `json:"urls,omitempty"`
+	SitemapURL *string  `json:"sitemap_url,omitempty"`
+	MaxDepth   int32    `json:"max_depth"`
+	MaxPages   *int32   `json:"max_pages,omitempty"`
+}
+
+type scrapeKnowledgeBaseDto struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	WebSourceDto
 }
 
 type createKnowledgeBaseUploadResponseDataDto struct {
@@ -41,5 +64,4 @@ type UpdateKnowledgeBaseDto struct {
 	Name        string  `json:"name"`
 	Description string  `json:"description"`
 	Text        *string `json:"text,omitempty"`
-	File        *[]byte `json:"-"` // Binary data for multipart form
 }
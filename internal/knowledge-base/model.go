@@ -3,15 +3,35 @@
 
 package knowledgebase
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 type KnowledgeBaseModel struct {
-	ID            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	Description   types.String `tfsdk:"description"`
-	FilePath      types.String `tfsdk:"file_path"`
-	Text          types.String `tfsdk:"text"`
-	ExtractedText types.String `tfsdk:"extracted_text"`
+	ID            types.String    `tfsdk:"id"`
+	Name          types.String    `tfsdk:"name"`
+	Description   types.String    `tfsdk:"description"`
+	FilePath      types.String    `tfsdk:"file_path"`
+	Text          types.String    `tfsdk:"text"`
+	WebSource     *WebSourceModel `tfsdk:"web_source"`
+	Documents     types.List      `tfsdk:"documents"`
+	ExtractedText types.String    `tfsdk:"extracted_text"`
+	SourceSHA256  types.String    `tfsdk:"source_sha256"`
+	Status        types.String    `tfsdk:"status"`
+	Timeouts      timeouts.Value  `tfsdk:"timeouts"`
+}
+
+type WebSourceModel struct {
+	URLs       types.List   `tfsdk:"urls"`
+	SitemapURL types.String `tfsdk:"sitemap_url"`
+	MaxDepth   types.Int32  `tfsdk:"max_depth"`
+	MaxPages   types.Int32  `tfsdk:"max_pages"`
+}
+
+type KnowledgeBaseDocumentModel struct {
+	URL   types.String `tfsdk:"url"`
+	Title types.String `tfsdk:"title"`
 }
 
 type KnowledgeBaseDataSourceModel struct {
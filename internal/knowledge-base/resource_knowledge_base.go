@@ -5,21 +5,30 @@ package knowledgebase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jameshiester/terraform-provider-bland/internal/api"
 	utils "github.com/jameshiester/terraform-provider-bland/internal/util"
 )
 
 var _ resource.Resource = &KnowledgeBaseResource{}
+var _ resource.ResourceWithModifyPlan = &KnowledgeBaseResource{}
 
 type KnowledgeBaseResource struct {
 	utils.TypeInfo
@@ -82,11 +91,11 @@ func (r *KnowledgeBaseResource) Schema(ctx context.Context, req resource.SchemaR
 				Required:            true,
 			},
 			"file_path": schema.StringAttribute{
-				MarkdownDescription: "Path to the file to upload as the knowledge base.",
+				MarkdownDescription: "Path to the file to upload as the knowledge base. The file is streamed to Bland with a content type matching its extension and must not exceed 50 MB. It is uploaded again, as a new knowledge base replacing the previous one, whenever its content changes.",
 				Optional:            true,
 				Sensitive:           true,
 				Validators: []validator.String{
-					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("text")),
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("text"), path.MatchRelative().AtParent().AtName("web_source")),
 				},
 			},
 			"text": schema.StringAttribute{
@@ -94,7 +103,61 @@ func (r *KnowledgeBaseResource) Schema(ctx context.Context, req resource.SchemaR
 				Optional:            true,
 				Sensitive:           true,
 				Validators: []validator.String{
-					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("file_path")),
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("file_path"), path.MatchRelative().AtParent().AtName("web_source")),
+				},
+			},
+			"web_source": schema.SingleNestedAttribute{
+				MarkdownDescription: "Web pages scraped by Bland as the knowledge base. The pages are scraped again, as a new knowledge base replacing the previous one, whenever this configuration changes.",
+				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("file_path"), path.MatchRelative().AtParent().AtName("text")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"urls": schema.ListAttribute{
+						MarkdownDescription: "URLs of the pages to scrape. At least one of `urls` or `sitemap_url` must be set.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("sitemap_url")),
+						},
+					},
+					"sitemap_url": schema.StringAttribute{
+						MarkdownDescription: "URL of a sitemap listing the pages to scrape.",
+						Optional:            true,
+					},
+					"max_depth": schema.Int32Attribute{
+						MarkdownDescription: "Number of links followed from the listed pages. Defaults to `0`, only scraping the listed pages.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int32default.StaticInt32(0),
+						Validators: []validator.Int32{
+							int32validator.AtLeast(0),
+						},
+					},
+					"max_pages": schema.Int32Attribute{
+						MarkdownDescription: "Maximum number of pages scraped.",
+						Optional:            true,
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+				},
+			},
+			"documents": schema.ListNestedAttribute{
+				MarkdownDescription: "Pages Bland scraped for a knowledge base created from `web_source`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							MarkdownDescription: "URL of the page.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Title of the page.",
+							Computed:            true,
+						},
+					},
 				},
 			},
 			"extracted_text": schema.StringAttribute{
@@ -102,12 +165,90 @@ func (r *KnowledgeBaseResource) Schema(ctx context.Context, req resource.SchemaR
 				Computed:            true,
 				Sensitive:           true,
 			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Ingestion status of the knowledge base. Creating or updating the knowledge base waits until Bland finished ingesting its content, within the `create` and `update` timeouts, and fails when the ingestion failed.",
+				Computed:            true,
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+			"source_sha256": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 digest of the content of `file_path`, or of the `web_source` configuration, computed during plan to detect changes to the source.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
 
+func (r *KnowledgeBaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan KnowledgeBaseModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourceSHA256 := types.StringNull()
+	if plan.WebSource != nil {
+		digest, err := webSourceDigest(ctx, plan.WebSource)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("web_source"), "Invalid web source", err.Error())
+			return
+		}
+		sourceSHA256 = digest
+	} else if plan.FilePath.IsUnknown() {
+		sourceSHA256 = types.StringUnknown()
+	} else if !plan.FilePath.IsNull() {
+		info, err := os.Stat(plan.FilePath.ValueString())
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			// The file may be written by another resource during apply.
+			sourceSHA256 = types.StringUnknown()
+		case err != nil:
+			resp.Diagnostics.AddAttributeError(path.Root("file_path"), "Unable to read knowledge base file", err.Error())
+			return
+		default:
+			if err := checkKnowledgeBaseFileSize(info); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("file_path"), "Invalid knowledge base file", err.Error())
+				return
+			}
+			digest, err := fileSHA256(plan.FilePath.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("file_path"), "Unable to read knowledge base file", err.Error())
+				return
+			}
+			sourceSHA256 = types.StringValue(digest)
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_sha256"), sourceSHA256)...)
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state KnowledgeBaseModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.SourceSHA256 = sourceSHA256
+	if knowledgeBaseReuploads(plan, state) {
+		tflog.Debug(ctx, fmt.Sprintf("Source of knowledge base %s changed, it will be created again", state.ID.ValueString()))
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	}
+}
+
 func (r *KnowledgeBaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	_, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
 	defer exitContext()
 
 	var plan KnowledgeBaseModel
@@ -122,16 +263,22 @@ func (r *KnowledgeBaseResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	read, err := r.KnowledgeBaseClient.ReadKnowledgeBase(ctx, *vectorID)
-	if err != nil {
+	read, err := r.KnowledgeBaseClient.WaitForKnowledgeBase(ctx, *vectorID)
+	if read == nil {
 		resp.Diagnostics.AddError("Error reading knowledge base", err.Error())
 		return
 	}
+	if err != nil {
+		// The knowledge base is saved so Terraform taints it and replaces it on the next apply.
+		resp.Diagnostics.AddError("Error ingesting knowledge base", err.Error())
+	}
 
-	model := ConvertFromKnowledgeBaseDto(*read)
-	model.FilePath = plan.FilePath
-	model.Text = plan.Text
-	resp.State.Set(ctx, model)
+	model, shaErr := ingestedKnowledgeBaseModel(ctx, *read, plan)
+	if shaErr != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("file_path"), "Unable to read knowledge base file", shaErr.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
 
 func (r *KnowledgeBaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -146,6 +293,10 @@ func (r *KnowledgeBaseResource) Read(ctx context.Context, req resource.ReadReque
 
 	read, err := r.KnowledgeBaseClient.ReadKnowledgeBase(ctx, state.ID.ValueString())
 	if err != nil {
+		if errors.Is(err, api.ErrObjectNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Error reading knowledge base", err.Error())
 		return
 	}
@@ -153,33 +304,74 @@ func (r *KnowledgeBaseResource) Read(ctx context.Context, req resource.ReadReque
 	model := ConvertFromKnowledgeBaseDto(*read)
 	model.FilePath = state.FilePath
 	model.Text = state.Text
+	model.WebSource = state.WebSource
+	model.SourceSHA256 = state.SourceSHA256
+	model.Timeouts = state.Timeouts
 	resp.State.Set(ctx, model)
 }
 
 func (r *KnowledgeBaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	_, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
 	defer exitContext()
 
 	var plan KnowledgeBaseModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	var state KnowledgeBaseModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	_, err := r.KnowledgeBaseClient.UpdateKnowledgeBase(ctx, plan.ID.ValueString(), plan)
-	if err != nil {
-		resp.Diagnostics.AddError("Error updating knowledge base", err.Error())
-		return
+
+	id := state.ID.ValueString()
+	var read *KnowledgeBaseDto
+	if knowledgeBaseReuploads(plan, state) {
+		// The PATCH endpoint never receives files nor pages, the new content is uploaded as a new knowledge base replacing
+		// the previous one.
+		vectorID, err := r.KnowledgeBaseClient.CreateKnowledgeBase(ctx, plan)
+		if err != nil {
+			resp.Diagnostics.AddError("Error uploading knowledge base", err.Error())
+			return
+		}
+		read, err = r.KnowledgeBaseClient.WaitForKnowledgeBase(ctx, *vectorID)
+		if err != nil {
+			// Keep the previous knowledge base, the upload is retried on the next apply. The new one is deleted even
+			// when the update timed out.
+			resp.Diagnostics.AddError("Error ingesting knowledge base", err.Error())
+			if deleteErr := r.KnowledgeBaseClient.DeleteKnowledgeBase(context.WithoutCancel(ctx), *vectorID); deleteErr != nil {
+				resp.Diagnostics.AddWarning("Error deleting failed knowledge base",
+					fmt.Sprintf("Knowledge base %s could not be ingested nor deleted, delete it from the dashboard: %s", *vectorID, deleteErr.Error()))
+			}
+			resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			return
+		}
+		id = *vectorID
+		err = r.KnowledgeBaseClient.DeleteKnowledgeBase(ctx, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddWarning("Error deleting previous knowledge base",
+				fmt.Sprintf("Knowledge base %s was replaced by %s but could not be deleted, delete it from the dashboard: %s", state.ID.ValueString(), id, err.Error()))
+		}
+	} else {
+		_, err := r.KnowledgeBaseClient.UpdateKnowledgeBase(ctx, id, plan)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating knowledge base", err.Error())
+			return
+		}
+		read, err = r.KnowledgeBaseClient.WaitForKnowledgeBase(ctx, id)
+		if read == nil {
+			resp.Diagnostics.AddError("Error reading knowledge base", err.Error())
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error ingesting knowledge base", err.Error())
+		}
 	}
-	read, err := r.KnowledgeBaseClient.ReadKnowledgeBase(ctx, plan.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading knowledge base", err.Error())
+
+	model, shaErr := ingestedKnowledgeBaseModel(ctx, *read, plan)
+	if shaErr != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("file_path"), "Unable to read knowledge base file", shaErr.Error())
 		return
 	}
-
-	model := ConvertFromKnowledgeBaseDto(*read)
-	model.FilePath = plan.FilePath
-	model.Text = plan.Text
-	resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
 
 func (r *KnowledgeBaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -200,3 +392,53 @@ func (r *KnowledgeBaseResource) Delete(ctx context.Context, req resource.DeleteR
 
 	resp.State.RemoveResource(ctx)
 }
+
+// knowledgeBaseReuploads reports whether applying the plan uploads the file, or scrapes the pages, of the knowledge base
+// again.
+func knowledgeBaseReuploads(plan, state KnowledgeBaseModel) bool {
+	return (!plan.FilePath.IsNull() || plan.WebSource != nil) && !plan.SourceSHA256.Equal(state.SourceSHA256)
+}
+
+// ingestedKnowledgeBaseModel returns the state of a knowledge base read after applying the plan.
+func ingestedKnowledgeBaseModel(ctx context.Context, read KnowledgeBaseDto, plan KnowledgeBaseModel) (KnowledgeBaseModel, error) {
+	model := ConvertFromKnowledgeBaseDto(read)
+	model.FilePath = plan.FilePath
+	model.Text = plan.Text
+	model.WebSource = plan.WebSource
+	model.Timeouts = plan.Timeouts
+	var err error
+	model.SourceSHA256, err = uploadedSHA256(ctx, plan)
+	return model, err
+}
+
+// uploadedSHA256 returns the digest planned for the source of the knowledge base, computing it when the file did not
+// exist yet or the web source was not known during plan.
+func uploadedSHA256(ctx context.Context, plan KnowledgeBaseModel) (types.String, error) {
+	if !plan.SourceSHA256.IsUnknown() {
+		return plan.SourceSHA256, nil
+	}
+	if plan.WebSource != nil {
+		return webSourceDigest(ctx, plan.WebSource)
+	}
+	digest, err := fileSHA256(plan.FilePath.ValueString())
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return types.StringValue(digest), nil
+}
+
+// webSourceDigest returns the digest of a web source, unknown until all its attributes are known.
+func webSourceDigest(ctx context.Context, webSource *WebSourceModel) (types.String, error) {
+	if webSource.URLs.IsUnknown() || webSource.SitemapURL.IsUnknown() || webSource.MaxDepth.IsUnknown() || webSource.MaxPages.IsUnknown() {
+		return types.StringUnknown(), nil
+	}
+	dto, err := ConvertToWebSourceDto(ctx, webSource)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	digest, err := webSourceSHA256(*dto)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return types.StringValue(digest), nil
+}
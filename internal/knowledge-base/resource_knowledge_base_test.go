@@ -6,9 +6,14 @@ package knowledgebase_test
 import (
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	knowledgebase "github.com/jameshiester/terraform-provider-bland/internal/knowledge-base"
 	"github.com/jameshiester/terraform-provider-bland/internal/mocks"
 	"github.com/jarcoal/httpmock"
 )
@@ -85,6 +90,7 @@ func TestUnitKnowledgeBaseResource_Validate_Create(t *testing.T) {
 					resource.TestCheckResourceAttr("bland_knowledge_base.kb", "description", "Test knowledge base description"),
 					resource.TestCheckResourceAttr("bland_knowledge_base.kb", "id", "kb_123"),
 					resource.TestCheckResourceAttr("bland_knowledge_base.kb", "extracted_text", "This is the extracted text from the knowledge base file."),
+					resource.TestCheckResourceAttr("bland_knowledge_base.kb", "status", "COMPLETED"),
 				),
 			},
 		},
@@ -136,3 +142,258 @@ func TestUnitKnowledgeBaseResource_Validate_Create_TextOnly(t *testing.T) {
 		},
 	})
 }
+
+func TestUnitKnowledgeBaseResource_Validate_Deleted_Outside_Terraform(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	deleted := false
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/v1/knowledgebases",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/resource/knowledge_base/Validate_Create/post_knowledge_base.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bland.ai/v1/knowledgebases/kb_123`,
+		func(req *http.Request) (*http.Response, error) {
+			if deleted {
+				return httpmock.NewStringResponse(http.StatusNotFound, `{"errors": [{"error": "NOT_FOUND", "message": "Knowledge base not found"}]}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/resource/knowledge_base/Validate_Create/get_knowledge_base.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://api.bland.ai/v1/knowledgebases/kb_123",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	config := `
+		resource "bland_knowledge_base" "kb" {
+			name        = "TestKnowledgeBase"
+			description = "Test knowledge base description"
+			text        = "This is the extracted text from the knowledge base file."
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("bland_knowledge_base.kb", "id", "kb_123"),
+			},
+			{
+				PreConfig:          func() { deleted = true },
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestUnitKnowledgeBaseResource_Validate_Update_File_Content(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	filePath := filepath.Join(t.TempDir(), "faq.txt")
+	if err := os.WriteFile(filePath, []byte("What are your opening hours?"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	uploads := 0
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/v1/knowledgebases/upload",
+		func(req *http.Request) (*http.Response, error) {
+			uploads++
+			return httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(`{"data": {"vector_id": "kb_%d"}}`, uploads)), nil
+		})
+
+	httpmock.RegisterResponder("GET", `=~^https://api.bland.ai/v1/knowledgebases/kb_\d+\z`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/resource/knowledge_base/Validate_Create/get_knowledge_base.json").String()), nil
+		})
+
+	deleted := []string{}
+	httpmock.RegisterResponder("DELETE", `=~^https://api.bland.ai/v1/knowledgebases/kb_\d+\z`,
+		func(req *http.Request) (*http.Response, error) {
+			deleted = append(deleted, req.URL.Path)
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	config := fmt.Sprintf(`
+		resource "bland_knowledge_base" "kb" {
+			name        = "TestKnowledgeBase"
+			description = "Test knowledge base description"
+			file_path   = "%s"
+		}
+	`, filePath)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bland_knowledge_base.kb", "id", "kb_1"),
+					resource.TestCheckResourceAttr("bland_knowledge_base.kb", "source_sha256", "c97fae5a0bf87f8120e20cf64fd14cecb9186d6409776ef6691a278d771ca9a1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(filePath, []byte("We are open from 9am to 5pm."), 0o600); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bland_knowledge_base.kb", "id", "kb_2"),
+					resource.TestCheckResourceAttr("bland_knowledge_base.kb", "source_sha256", "729e2164cb189fd83dc0ee82f7e1fe8a1236c0ba84f680cf4d54769dfac3bf11"),
+					func(*terraform.State) error {
+						if uploads != 2 || len(deleted) != 1 || deleted[0] != "/v1/knowledgebases/kb_1" {
+							return fmt.Errorf("expected the file to be uploaded again and kb_1 deleted, got %d uploads and deletes %v", uploads, deleted)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKnowledgeBaseResource_Validate_Web_Source(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	scrapes := 0
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/v1/knowledgebases/scrape",
+		func(req *http.Request) (*http.Response, error) {
+			scrapes++
+			return httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(`{"data": {"vector_id": "kb_%d"}}`, scrapes)), nil
+		})
+
+	httpmock.RegisterResponder("GET", `=~^https://api.bland.ai/v1/knowledgebases/kb_\d+\z`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/resource/knowledge_base/Validate_Web_Source/get_knowledge_base.json").String()), nil
+		})
+
+	deleted := []string{}
+	httpmock.RegisterResponder("DELETE", `=~^https://api.bland.ai/v1/knowledgebases/kb_\d+\z`,
+		func(req *http.Request) (*http.Response, error) {
+			deleted = append(deleted, req.URL.Path)
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "bland_knowledge_base" "kb" {
+						name        = "Help center"
+						description = "Public help center pages"
+						web_source = {
+							urls      = ["https://help.example.com"]
+							max_depth = 1
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bland_knowledge_base.kb", "id", "kb_1"),
+					resource.TestCheckResourceAttr("bland_knowledge_base.kb", "documents.#", "2"),
+					resource.TestCheckResourceAttr("bland_knowledge_base.kb", "documents.0.url", "https://help.example.com"),
+					resource.TestCheckResourceAttr("bland_knowledge_base.kb", "documents.1.title", "Opening hours"),
+					resource.TestCheckResourceAttrSet("bland_knowledge_base.kb", "source_sha256"),
+				),
+			},
+			{
+				Config: `
+					resource "bland_knowledge_base" "kb" {
+						name        = "Help center"
+						description = "Public help center pages"
+						web_source = {
+							sitemap_url = "https://help.example.com/sitemap.xml"
+							max_pages   = 50
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bland_knowledge_base.kb", "id", "kb_2"),
+					resource.TestCheckResourceAttr("bland_knowledge_base.kb", "web_source.max_depth", "0"),
+					func(*terraform.State) error {
+						if scrapes != 2 || len(deleted) != 1 || deleted[0] != "/v1/knowledgebases/kb_1" {
+							return fmt.Errorf("expected the pages to be scraped again and kb_1 deleted, got %d scrapes and deletes %v", scrapes, deleted)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKnowledgeBaseResource_Validate_File_Too_Large(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "manual.pdf")
+	if err := os.WriteFile(filePath, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Truncate(filePath, knowledgebase.MaxKnowledgeBaseFileSize+1); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "bland_knowledge_base" "kb" {
+						name        = "TestKnowledgeBase"
+						description = "Test knowledge base description"
+						file_path   = "%s"
+					}
+				`, filePath),
+				ExpectError: regexp.MustCompile("Invalid knowledge base file"),
+			},
+		},
+	})
+}
+
+func TestUnitKnowledgeBaseResource_Validate_Ingestion_Failed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "https://api.bland.ai/v1/knowledgebases",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/resource/knowledge_base/Validate_Create/post_knowledge_base.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bland.ai/v1/knowledgebases/kb_123`,
+		httpmock.ResponderFromMultipleResponses([]*http.Response{
+			httpmock.NewStringResponse(http.StatusOK, `{"data": {"name": "TestKnowledgeBase", "description": "Test knowledge base description", "status": "PROCESSING"}}`),
+			httpmock.NewStringResponse(http.StatusOK, `{"data": {"name": "TestKnowledgeBase", "description": "Test knowledge base description", "status": "FAILED"}}`),
+		}))
+
+	httpmock.RegisterResponder("DELETE", "https://api.bland.ai/v1/knowledgebases/kb_123",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "bland_knowledge_base" "kb" {
+						name        = "TestKnowledgeBase"
+						description = "Test knowledge base description"
+						text        = "This is the extracted text from the knowledge base file."
+					}
+				`,
+				ExpectError: regexp.MustCompile("ingestion of knowledge base 'kb_123' failed with status FAILED"),
+			},
+		},
+	})
+}
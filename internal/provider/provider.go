@@ -8,6 +8,8 @@ import (
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -22,6 +24,8 @@ import (
 
 // Ensure BlandProvider satisfies various provider interfaces.
 var _ provider.Provider = &BlandProvider{}
+var _ provider.ProviderWithFunctions = &BlandProvider{}
+var _ provider.ProviderWithEphemeralResources = &BlandProvider{}
 
 // BlandProvider defines the provider implementation.
 type BlandProvider struct {
@@ -94,11 +98,15 @@ func (p *BlandProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	}
 	resp.DataSourceData = &providerClient
 	resp.ResourceData = &providerClient
+	resp.EphemeralResourceData = &providerClient
 }
 
 func (p *BlandProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		func() resource.Resource { return pathways.NewConversationalPathwayResource() },
+		func() resource.Resource { return pathways.NewConversationalPathwayDeploymentResource() },
+		func() resource.Resource { return pathways.NewPathwayTestResource() },
+		func() resource.Resource { return pathways.NewPathwayFolderResource() },
 		func() resource.Resource { return secret.NewSecretResource() },
 		func() resource.Resource { return knowledgebase.NewKnowledgeBaseResource() },
 	}
@@ -107,11 +115,27 @@ func (p *BlandProvider) Resources(ctx context.Context) []func() resource.Resourc
 func (p *BlandProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		func() datasource.DataSource { return pathways.NewConversationalPathwayDataSource() },
+		func() datasource.DataSource { return pathways.NewConversationalPathwayVersionsDataSource() },
+		func() datasource.DataSource { return pathways.NewConversationalPathwaysDataSource() },
 		func() datasource.DataSource { return secret.NewSecretDataSource() },
+		func() datasource.DataSource { return secret.NewSecretsDataSource() },
 		func() datasource.DataSource { return knowledgebase.NewKnowledgeBaseDataSource() },
 	}
 }
 
+func (p *BlandProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		func() ephemeral.EphemeralResource { return secret.NewSecretEphemeralResource() },
+	}
+}
+
+func (p *BlandProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		func() function.Function { return pathways.NewPathwayGraphFunction() },
+		func() function.Function { return pathways.NewPathwayFragmentFunction() },
+	}
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &BlandProvider{
@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	test "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	pathways "github.com/jameshiester/terraform-provider-bland/internal/conversational-pathways"
@@ -22,7 +24,10 @@ import (
 func TestUnitBlandProviderHasChildDataSources_Basic(t *testing.T) {
 	expectedDataSources := []datasource.DataSource{
 		pathways.NewConversationalPathwayDataSource(),
+		pathways.NewConversationalPathwayVersionsDataSource(),
+		pathways.NewConversationalPathwaysDataSource(),
 		secret.NewSecretDataSource(),
+		secret.NewSecretsDataSource(),
 		knowledgebase.NewKnowledgeBaseDataSource(),
 	}
 	providerInstance := provider.NewBlandProvider(context.Background())()
@@ -37,6 +42,9 @@ func TestUnitBlandProviderHasChildDataSources_Basic(t *testing.T) {
 func TestUnitBlandProviderHasChildResources_Basic(t *testing.T) {
 	expectedResources := []resource.Resource{
 		pathways.NewConversationalPathwayResource(),
+		pathways.NewConversationalPathwayDeploymentResource(),
+		pathways.NewPathwayTestResource(),
+		pathways.NewPathwayFolderResource(),
 		secret.NewSecretResource(),
 		knowledgebase.NewKnowledgeBaseResource(),
 	}
@@ -49,6 +57,41 @@ func TestUnitBlandProviderHasChildResources_Basic(t *testing.T) {
 	}
 }
 
+func TestUnitBlandProviderHasChildFunctions_Basic(t *testing.T) {
+	expectedFunctions := []function.Function{
+		pathways.NewPathwayGraphFunction(),
+		pathways.NewPathwayFragmentFunction(),
+	}
+	providerInstance := provider.NewBlandProvider(context.Background())()
+	providerWithFunctions, ok := providerInstance.(interface {
+		Functions(context.Context) []func() function.Function
+	})
+	require.True(t, ok, "Provider does not implement functions")
+	functions := providerWithFunctions.Functions(context.Background())
+
+	require.Equalf(t, len(expectedFunctions), len(functions), "Expected %d functions, got %d", len(expectedFunctions), len(functions))
+	for _, f := range functions {
+		require.Containsf(t, expectedFunctions, f(), "Function %+v was not expected", f())
+	}
+}
+
+func TestUnitBlandProviderHasChildEphemeralResources_Basic(t *testing.T) {
+	expectedEphemeralResources := []ephemeral.EphemeralResource{
+		secret.NewSecretEphemeralResource(),
+	}
+	providerInstance := provider.NewBlandProvider(context.Background())()
+	providerWithEphemeralResources, ok := providerInstance.(interface {
+		EphemeralResources(context.Context) []func() ephemeral.EphemeralResource
+	})
+	require.True(t, ok, "Provider does not implement ephemeral resources")
+	ephemeralResources := providerWithEphemeralResources.EphemeralResources(context.Background())
+
+	require.Equalf(t, len(expectedEphemeralResources), len(ephemeralResources), "Expected %d ephemeral resources, got %d", len(expectedEphemeralResources), len(ephemeralResources))
+	for _, e := range ephemeralResources {
+		require.Containsf(t, expectedEphemeralResources, e(), "Ephemeral resource %+v was not expected", e())
+	}
+}
+
 func TestBlandProvider_Validate_Telementry_Optout_Is_False(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -14,6 +14,20 @@ import (
 
 func ConvertFromSecretDto(dto secretDto) SecretModel {
 	return SecretModel{
+		ID:               types.StringValue(dto.ID),
+		Name:             types.StringValue(dto.Name),
+		Value:            types.StringPointerValue(dto.Value),
+		ValueWO:          types.StringNull(),
+		ValueWOVersion:   types.Int64Null(),
+		Static:           types.BoolPointerValue(dto.Static),
+		Config:           ConvertFromSecretConfigDto(dto.Config),
+		RotationTriggers: types.MapNull(types.StringType),
+		LastRefreshedAt:  types.StringNull(),
+	}
+}
+
+func ConvertFromSecretDtoToDataSourceModel(dto secretDto) SecretDataSourceModel {
+	return SecretDataSourceModel{
 		ID:     types.StringValue(dto.ID),
 		Name:   types.StringValue(dto.Name),
 		Value:  types.StringPointerValue(dto.Value),
@@ -22,6 +36,15 @@ func ConvertFromSecretDto(dto secretDto) SecretModel {
 	}
 }
 
+func ConvertFromSecretDtoToSummaryModel(dto secretDto) SecretSummaryModel {
+	return SecretSummaryModel{
+		ID:     types.StringValue(dto.ID),
+		Name:   types.StringValue(dto.Name),
+		Static: types.BoolPointerValue(dto.Static),
+		Config: ConvertFromSecretConfigDto(dto.Config),
+	}
+}
+
 func ConvertToSecretConfigDto(ctx context.Context, model *SecretConfigModel) (*secretConfigDto, error) {
 	if model == nil {
 		return nil, nil
@@ -33,13 +56,16 @@ func ConvertToSecretConfigDto(ctx context.Context, model *SecretConfigModel) (*s
 		Body:            model.Body.ValueStringPointer(),
 		RefreshInterval: model.RefreshInterval.ValueInt32(),
 	}
-	var headers map[string]string
 	if !model.Headers.IsNull() {
 		elements := make(map[string]types.String, len(model.Headers.Elements()))
 		diags := model.Headers.ElementsAs(ctx, &elements, false)
 		if diags.HasError() {
 			return nil, errors.New("headers are not valid")
 		}
+		headers := make(map[string]string, len(elements))
+		for k, v := range elements {
+			headers[k] = v.ValueString()
+		}
 		dto.Headers = &headers
 	}
 	return &dto, nil
@@ -70,6 +96,34 @@ func ConvertFromSecretConfigDto(dto *secretConfigDto) *SecretConfigModel {
 	}
 }
 
+// normalizeSecretConfig keeps optional attributes of the prior config that Bland returns as empty values, so reading a
+// secret only reports drift for values that actually changed.
+func normalizeSecretConfig(read, prior *SecretConfigModel) *SecretConfigModel {
+	if read == nil || prior == nil {
+		return read
+	}
+	if prior.Headers.IsNull() && len(read.Headers.Elements()) == 0 {
+		read.Headers = prior.Headers
+	}
+	if prior.Body.IsNull() && read.Body.ValueString() == "" {
+		read.Body = prior.Body
+	}
+	return read
+}
+
+// secretConfigEqual reports whether two refresh configurations send the same request.
+func secretConfigEqual(a, b *SecretConfigModel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.URL.Equal(b.URL) &&
+		a.Method.Equal(b.Method) &&
+		a.Response.Equal(b.Response) &&
+		a.Body.Equal(b.Body) &&
+		a.RefreshInterval.Equal(b.RefreshInterval) &&
+		a.Headers.Equal(b.Headers)
+}
+
 func ConvertToSecretDto(ctx context.Context, model SecretModel) (*secretDto, error) {
 	dto := secretDto{
 		ID:    model.ID.ValueString(),
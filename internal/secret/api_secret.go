@@ -5,6 +5,7 @@ package secret
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -52,11 +53,51 @@ func (c *SecretClient) ReadSecret(ctx context.Context, id string) (*secretDto, e
 	var secret readSecretDto
 	_, err := c.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &secret)
 	if err != nil {
+		var httpErr api.UnexpectedHttpStatusCodeError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return nil, api.WrapIntoProviderError(err, api.ErrorCode(constants.ERROR_OBJECT_NOT_FOUND), fmt.Sprintf("Secret '%s' not found", id))
+		}
 		return nil, fmt.Errorf("failed to read secret: %w", err)
 	}
 	return &secret.Data.Secret, nil
 }
 
+func (c *SecretClient) ListSecrets(ctx context.Context) ([]secretDto, error) {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   c.Api.Config.BaseURL,
+		Path:   "/v1/secrets",
+	}
+	var secrets listSecretsDto
+	_, err := c.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &secrets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secrets: %w", err)
+	}
+	return secrets.Data, nil
+}
+
+// FindSecretByName returns the only secret with the given name.
+func (c *SecretClient) FindSecretByName(ctx context.Context, name string) (*secretDto, error) {
+	secrets, err := c.ListSecrets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var found *secretDto
+	for i := range secrets {
+		if secrets[i].Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("secrets %s and %s are both named '%s', use id instead", found.ID, secrets[i].ID, name)
+		}
+		found = &secrets[i]
+	}
+	if found == nil {
+		return nil, api.WrapIntoProviderError(nil, api.ErrorCode(constants.ERROR_OBJECT_NOT_FOUND), fmt.Sprintf("Secret '%s' not found", name))
+	}
+	return found, nil
+}
+
 func (c *SecretClient) UpdateSecret(ctx context.Context, secretID string, secret updateSecretDto) (*secretDto, error) {
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
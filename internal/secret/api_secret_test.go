@@ -0,0 +1,49 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package secret
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/jameshiester/terraform-provider-bland/internal/api"
+	"github.com/jameshiester/terraform-provider-bland/internal/config"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretClient_FindSecretByName(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://api.bland.ai/v1/secrets",
+		httpmock.NewStringResponder(http.StatusOK, `{"data": [{"id": "s1", "name": "crm_token"}, {"id": "s2", "name": "shared"}, {"id": "s3", "name": "shared"}]}`),
+	)
+
+	client := SecretClient{Api: &api.Client{Config: &config.ProviderConfig{BaseURL: "api.bland.ai", APIKey: "123"}}}
+	secret, err := client.FindSecretByName(context.Background(), "crm_token")
+	require.NoError(t, err)
+	require.Equal(t, "s1", secret.ID)
+
+	_, err = client.FindSecretByName(context.Background(), "shared")
+	require.ErrorContains(t, err, "s2 and s3")
+
+	_, err = client.FindSecretByName(context.Background(), "missing")
+	require.True(t, errors.Is(err, api.ErrObjectNotFound))
+}
+
+func TestSecretClient_ReadSecret_NotFound(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://api.bland.ai/v1/secrets/s1",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"errors": [{"error": "NOT_FOUND", "message": "Secret not found"}]}`),
+	)
+
+	client := SecretClient{Api: &api.Client{Config: &config.ProviderConfig{BaseURL: "api.bland.ai", APIKey: "123"}}}
+	_, err := client.ReadSecret(context.Background(), "s1")
+	require.True(t, errors.Is(err, api.ErrObjectNotFound))
+}
@@ -7,8 +7,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jameshiester/terraform-provider-bland/internal/api"
@@ -42,15 +45,20 @@ func (d *SecretDataSource) Metadata(ctx context.Context, req datasource.Metadata
 
 func (d *SecretDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Data source to retrieve a specific secret by `id`.",
+		MarkdownDescription: "Data source to retrieve a specific secret by `id` or `name`.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The unique identifier of the secret for which you want to retrieve detailed information.",
-				Required:            true,
+				MarkdownDescription: "The unique identifier of the secret for which you want to retrieve detailed information. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the secret.",
+				MarkdownDescription: "The name of the secret. Exactly one of `id` or `name` must be set, the name must identify a single secret.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"value": schema.StringAttribute{
@@ -69,13 +77,14 @@ func (d *SecretDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 					"body": schema.StringAttribute{
 						MarkdownDescription: "JSON body for the refresh request.",
 						Computed:            true,
+						Sensitive:           true,
 					},
 					"method": schema.StringAttribute{
 						MarkdownDescription: "HTTP method for the refresh request.",
 						Computed:            true,
 					},
 					"refresh_interval": schema.Int32Attribute{
-						MarkdownDescription: "Refresh interval for the refresh request.",
+						MarkdownDescription: "Interval in seconds at which Bland sends the refresh request and updates the secret value.",
 						Computed:            true,
 					},
 					"response": schema.StringAttribute{
@@ -90,6 +99,7 @@ func (d *SecretDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 						MarkdownDescription: "Headers for the refresh request.",
 						ElementType:         types.StringType,
 						Computed:            true,
+						Sensitive:           true,
 					},
 				},
 			},
@@ -126,12 +136,22 @@ func (d *SecretDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	secret, err := d.SecretClient.ReadSecret(ctx, data.ID.ValueString())
+	id := data.ID.ValueString()
+	if data.ID.IsNull() {
+		found, err := d.SecretClient.FindSecretByName(ctx, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading secret", err.Error())
+			return
+		}
+		id = found.ID
+	}
+
+	secret, err := d.SecretClient.ReadSecret(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading secret", err.Error())
 		return
 	}
 
-	model := ConvertFromSecretDto(*secret)
+	model := ConvertFromSecretDtoToDataSourceModel(*secret)
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
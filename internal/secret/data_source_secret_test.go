@@ -5,6 +5,7 @@ package secret_test
 
 import (
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -47,3 +48,54 @@ func TestUnitSecretDataSource_Validate_Read(t *testing.T) {
 		},
 	})
 }
+
+func TestUnitSecretDataSource_Validate_Read_By_Name(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bland.ai/v1/secrets`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/datasource/Validate_Read_List/list_secrets.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bland.ai/v1/secrets/secret123`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/datasource/Validate_Read/get_secret.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "bland_secret" "secret" {
+					name = "TestSecret"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bland_secret.secret", "id", "secret123"),
+					resource.TestCheckResourceAttr("data.bland_secret.secret", "name", "TestSecret"),
+					resource.TestCheckResourceAttr("data.bland_secret.secret", "config.url", "https://api.example.com/secret"),
+				),
+			},
+			{
+				Config: `
+				data "bland_secret" "secret" {
+					name = "MissingSecret"
+				}
+				`,
+				ExpectError: regexp.MustCompile("Secret 'MissingSecret' not found"),
+			},
+			{
+				Config: `
+				data "bland_secret" "secret" {
+					id   = "secret123"
+					name = "TestSecret"
+				}
+				`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
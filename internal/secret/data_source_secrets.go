@@ -0,0 +1,156 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package secret
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jameshiester/terraform-provider-bland/internal/api"
+	utils "github.com/jameshiester/terraform-provider-bland/internal/util"
+)
+
+var _ datasource.DataSource = &SecretsDataSource{}
+
+type SecretsDataSource struct {
+	utils.TypeInfo
+	SecretClient *SecretClient
+}
+
+func NewSecretsDataSource() datasource.DataSource {
+	return &SecretsDataSource{
+		TypeInfo: utils.TypeInfo{
+			TypeName: "secrets",
+		},
+	}
+}
+
+func (d *SecretsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	d.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := utils.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	resp.TypeName = d.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (d *SecretsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to list all secrets of the account. Secret values are not returned, use the `bland_secret` data source or ephemeral resource to read them.",
+
+		Attributes: map[string]schema.Attribute{
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the listed secrets, in the same order as `secrets`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"secrets": schema.ListNestedAttribute{
+				MarkdownDescription: "Secrets of the account, ordered by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the secret.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the secret.",
+							Computed:            true,
+						},
+						"static": schema.BoolAttribute{
+							MarkdownDescription: "Defines if secret is static or refreshes.",
+							Computed:            true,
+						},
+						"config": schema.SingleNestedAttribute{
+							MarkdownDescription: "Configuration for refreshable secret.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"body": schema.StringAttribute{
+									MarkdownDescription: "JSON body for the refresh request.",
+									Computed:            true,
+									Sensitive:           true,
+								},
+								"method": schema.StringAttribute{
+									MarkdownDescription: "HTTP method for the refresh request.",
+									Computed:            true,
+								},
+								"refresh_interval": schema.Int32Attribute{
+									MarkdownDescription: "Interval in seconds at which Bland sends the refresh request and updates the secret value.",
+									Computed:            true,
+								},
+								"response": schema.StringAttribute{
+									MarkdownDescription: "Value to extract from the refresh request response.",
+									Computed:            true,
+								},
+								"url": schema.StringAttribute{
+									MarkdownDescription: "URL for the refresh request.",
+									Computed:            true,
+								},
+								"headers": schema.MapAttribute{
+									MarkdownDescription: "Headers for the refresh request.",
+									ElementType:         types.StringType,
+									Computed:            true,
+									Sensitive:           true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SecretsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	_, exitContext := utils.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.SecretClient = newSecretClient(client.Api)
+}
+
+func (d *SecretsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	secrets, err := d.SecretClient.ListSecrets(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing secrets", err.Error())
+		return
+	}
+	sort.SliceStable(secrets, func(i, j int) bool {
+		if secrets[i].Name != secrets[j].Name {
+			return secrets[i].Name < secrets[j].Name
+		}
+		return secrets[i].ID < secrets[j].ID
+	})
+	tflog.Debug(ctx, fmt.Sprintf("%d secrets listed by %s", len(secrets), d.FullTypeName()))
+
+	state := SecretsDataSourceModel{
+		IDs:     make([]types.String, 0, len(secrets)),
+		Secrets: make([]SecretSummaryModel, 0, len(secrets)),
+	}
+	for _, secret := range secrets {
+		summary := ConvertFromSecretDtoToSummaryModel(secret)
+		state.IDs = append(state.IDs, summary.ID)
+		state.Secrets = append(state.Secrets, summary)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package secret_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jameshiester/terraform-provider-bland/internal/mocks"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestUnitSecretsDataSource_Validate_Read(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bland.ai/v1/secrets`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/datasource/Validate_Read_List/list_secrets.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "bland_secrets" "all" {}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bland_secrets.all", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.bland_secrets.all", "ids.0", "secret123"),
+					resource.TestCheckResourceAttr("data.bland_secrets.all", "secrets.#", "2"),
+					resource.TestCheckResourceAttr("data.bland_secrets.all", "secrets.0.name", "TestSecret"),
+					resource.TestCheckResourceAttr("data.bland_secrets.all", "secrets.0.static", "false"),
+					resource.TestCheckResourceAttr("data.bland_secrets.all", "secrets.0.config.url", "https://api.example.com/secret"),
+					resource.TestCheckResourceAttr("data.bland_secrets.all", "secrets.0.config.refresh_interval", "300"),
+					resource.TestCheckResourceAttr("data.bland_secrets.all", "secrets.0.config.headers.Authorization", "Bearer token"),
+					resource.TestCheckResourceAttr("data.bland_secrets.all", "secrets.1.id", "secret456"),
+					resource.TestCheckResourceAttr("data.bland_secrets.all", "secrets.1.name", "WebhookToken"),
+					resource.TestCheckResourceAttr("data.bland_secrets.all", "secrets.1.static", "true"),
+					resource.TestCheckNoResourceAttr("data.bland_secrets.all", "secrets.1.config"),
+				),
+			},
+		},
+	})
+}
@@ -34,6 +34,10 @@ type readSecretDto struct {
 	Data readSecretDataDto `json:"data"`
 }
 
+type listSecretsDto struct {
+	Data []secretDto `json:"data"`
+}
+
 type updateSecretDto struct {
 	Name   string           `json:"name"`
 	Value  *string          `json:"secret,omitempty"`
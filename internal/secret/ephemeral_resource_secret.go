@@ -0,0 +1,162 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package secret
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jameshiester/terraform-provider-bland/internal/api"
+	utils "github.com/jameshiester/terraform-provider-bland/internal/util"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &SecretEphemeralResource{}
+
+type SecretEphemeralResource struct {
+	utils.TypeInfo
+	SecretClient *SecretClient
+}
+
+func NewSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &SecretEphemeralResource{
+		TypeInfo: utils.TypeInfo{
+			TypeName: "secret",
+		},
+	}
+}
+
+func (r *SecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *SecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	_, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a secret by `id` or `name` at apply time. The value is only available to ephemeral contexts, such as provider configuration or write-only arguments, and is never stored in the plan or state. Requires Terraform 1.10 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the secret. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the secret. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The value of the secret, when Bland returns it.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"static": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Defines if secret is static or refreshes.",
+			},
+			"config": schema.SingleNestedAttribute{
+				MarkdownDescription: "Configuration for refreshable secret.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"body": schema.StringAttribute{
+						MarkdownDescription: "JSON body for the refresh request.",
+						Computed:            true,
+						Sensitive:           true,
+					},
+					"method": schema.StringAttribute{
+						MarkdownDescription: "HTTP method for the refresh request.",
+						Computed:            true,
+					},
+					"refresh_interval": schema.Int32Attribute{
+						MarkdownDescription: "Interval in seconds at which Bland sends the refresh request and updates the secret value.",
+						Computed:            true,
+					},
+					"response": schema.StringAttribute{
+						MarkdownDescription: "Value to extract from the refresh request response.",
+						Computed:            true,
+					},
+					"url": schema.StringAttribute{
+						MarkdownDescription: "URL for the refresh request.",
+						Computed:            true,
+					},
+					"headers": schema.MapAttribute{
+						MarkdownDescription: "Headers for the refresh request.",
+						ElementType:         types.StringType,
+						Computed:            true,
+						Sensitive:           true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *SecretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	_, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.SecretClient = newSecretClient(client.Api)
+}
+
+func (r *SecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var data SecretDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.ID.ValueString()
+	if data.ID.IsNull() {
+		found, err := r.SecretClient.FindSecretByName(ctx, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading secret", err.Error())
+			return
+		}
+		id = found.ID
+	}
+
+	secret, err := r.SecretClient.ReadSecret(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading secret", err.Error())
+		return
+	}
+
+	model := ConvertFromSecretDtoToDataSourceModel(*secret)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, model)...)
+}
@@ -0,0 +1,106 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package secret_test
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/jameshiester/terraform-provider-bland/internal/mocks"
+	"github.com/jarcoal/httpmock"
+)
+
+func ephemeralTestProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	factories := map[string]func() (tfprotov6.ProviderServer, error){
+		"echo": echoprovider.NewProviderServer(),
+	}
+	for name, factory := range mocks.TestUnitTestProtoV6ProviderFactories {
+		factories[name] = factory
+	}
+	return factories
+}
+
+func TestUnitSecretEphemeralResource_Validate_Open(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bland.ai/v1/secrets`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/ephemeral/Validate_Open/list_secrets.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bland.ai/v1/secrets/secret123`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/ephemeral/Validate_Open/get_secret.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: ephemeralTestProviderFactories(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				ephemeral "bland_secret" "secret" {
+					name = "TestSecret"
+				}
+
+				provider "echo" {
+					data = ephemeral.bland_secret.secret
+				}
+
+				resource "echo" "secret" {}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.secret", "data.id", "secret123"),
+					resource.TestCheckResourceAttr("echo.secret", "data.name", "TestSecret"),
+					resource.TestCheckResourceAttr("echo.secret", "data.static", "false"),
+					resource.TestCheckResourceAttr("echo.secret", "data.config.url", "https://api.example.com/secret"),
+					resource.TestCheckResourceAttr("echo.secret", "data.config.headers.Authorization", "Bearer token"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitSecretEphemeralResource_Validate_Open_Not_Found(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bland.ai/v1/secrets`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("./tests/ephemeral/Validate_Open/list_secrets.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: ephemeralTestProviderFactories(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				ephemeral "bland_secret" "secret" {
+					name = "MissingSecret"
+				}
+
+				provider "echo" {
+					data = ephemeral.bland_secret.secret
+				}
+
+				resource "echo" "secret" {}
+				`,
+				ExpectError: regexp.MustCompile("Secret 'MissingSecret' not found"),
+			},
+		},
+	})
+}
@@ -15,6 +15,18 @@ type SecretConfigModel struct {
 }
 
 type SecretModel struct {
+	ID               types.String       `tfsdk:"id"`
+	Name             types.String       `tfsdk:"name"`
+	Static           types.Bool         `tfsdk:"static"`
+	Value            types.String       `tfsdk:"value"`
+	ValueWO          types.String       `tfsdk:"value_wo"`
+	ValueWOVersion   types.Int64        `tfsdk:"value_wo_version"`
+	Config           *SecretConfigModel `tfsdk:"config"`
+	RotationTriggers types.Map          `tfsdk:"rotation_triggers"`
+	LastRefreshedAt  types.String       `tfsdk:"last_refreshed_at"`
+}
+
+type SecretDataSourceModel struct {
 	ID     types.String       `tfsdk:"id"`
 	Name   types.String       `tfsdk:"name"`
 	Static types.Bool         `tfsdk:"static"`
@@ -22,10 +34,16 @@ type SecretModel struct {
 	Config *SecretConfigModel `tfsdk:"config"`
 }
 
-type SecretDataSourceModel struct {
+// SecretSummaryModel describes a secret listed by the secret list data source.
+type SecretSummaryModel struct {
 	ID     types.String       `tfsdk:"id"`
 	Name   types.String       `tfsdk:"name"`
 	Static types.Bool         `tfsdk:"static"`
-	Value  types.String       `tfsdk:"value"`
 	Config *SecretConfigModel `tfsdk:"config"`
 }
+
+// SecretsDataSourceModel describes the secret list data source data model.
+type SecretsDataSourceModel struct {
+	IDs     []types.String       `tfsdk:"ids"`
+	Secrets []SecretSummaryModel `tfsdk:"secrets"`
+}
@@ -0,0 +1,26 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package secret
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// PlaceholderPattern matches a reference to a secret in pathway nodes, the first group is the secret name.
+var PlaceholderPattern = regexp.MustCompile(`\{\{\s*secrets\.([^{}\s]+)\s*\}\}`)
+
+// Placeholder returns the text Bland replaces with the value of the named secret when a pathway runs.
+func Placeholder(name string) string {
+	return fmt.Sprintf("{{secrets.%s}}", name)
+}
+
+// PlaceholderName returns the secret name if value is exactly a secret placeholder.
+func PlaceholderName(value string) (string, bool) {
+	match := PlaceholderPattern.FindStringSubmatch(value)
+	if match == nil || match[0] != value {
+		return "", false
+	}
+	return match[1], true
+}
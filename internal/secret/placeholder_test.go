@@ -0,0 +1,22 @@
+// Copyright (c) James Hiester.
+// SPDX-License-Identifier: MPL-2.0
+
+package secret_test
+
+import (
+	"testing"
+
+	"github.com/jameshiester/terraform-provider-bland/internal/secret"
+)
+
+func TestPlaceholderName(t *testing.T) {
+	if placeholder := secret.Placeholder("crm_token"); placeholder != "{{secrets.crm_token}}" {
+		t.Errorf("unexpected placeholder %q", placeholder)
+	}
+	if name, ok := secret.PlaceholderName("{{ secrets.crm_token }}"); !ok || name != "crm_token" {
+		t.Errorf("expected crm_token, got %q %v", name, ok)
+	}
+	if _, ok := secret.PlaceholderName("Bearer {{secrets.crm_token}}"); ok {
+		t.Error("expected a value embedding a placeholder not to be a placeholder")
+	}
+}
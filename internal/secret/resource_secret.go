@@ -5,10 +5,14 @@ package secret
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jameshiester/terraform-provider-bland/internal/api"
@@ -24,6 +29,7 @@ import (
 )
 
 var _ resource.ResourceWithValidateConfig = &SecretResource{}
+var _ resource.ResourceWithModifyPlan = &SecretResource{}
 
 type SecretResource struct {
 	utils.TypeInfo
@@ -47,27 +53,27 @@ func (r SecretResource) ValidateConfig(ctx context.Context, req resource.Validat
 		return
 	}
 
-	// If attribute_one is not configured, return without warning.
-	if data.Static.ValueBool() && data.Value.ValueStringPointer() != nil {
+	// Static secrets are sent to Bland as is, so they need a value from either 'value' or 'value_wo'.
+	if data.Static.ValueBool() && (data.Value.ValueStringPointer() != nil || data.ValueWO.ValueStringPointer() != nil) {
 		return
 	}
 
-	// If attribute_two is not null, return without warning.
+	// Dynamic secrets are fetched by Bland with the request described in 'config'.
 	if !data.Static.ValueBool() && data.Config != nil {
 		return
 	}
 	if data.Static.ValueBool() {
 		resp.Diagnostics.AddAttributeWarning(
-			path.Root("attribute_two"),
+			path.Root("value"),
 			"Missing Attribute Configuration",
-			"Expected 'value' to be set when static is set to true. "+
+			"Expected 'value' or 'value_wo' to be set when static is set to true. "+
 				"The resource may return unexpected results.",
 		)
 	} else {
 		resp.Diagnostics.AddAttributeWarning(
-			path.Root("attribute_two"),
+			path.Root("config"),
 			"Missing Attribute Configuration",
-			"Expected 'config' to be set when static is set to true. "+
+			"Expected 'config' to be set when static is set to false. "+
 				"The resource may return unexpected results.",
 		)
 	}
@@ -107,7 +113,7 @@ func (r *SecretResource) Configure(ctx context.Context, req resource.ConfigureRe
 
 func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a secret.",
+		MarkdownDescription: "Manages a secret. Static secrets hold a fixed `value`, refreshing secrets are fetched by Bland from the endpoint described in `config`.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -128,14 +134,45 @@ func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"value": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "The value for a static secret.",
+				MarkdownDescription: "The value for a static secret. It is stored in the state, use `value_wo` to keep it out of the state.",
 				Sensitive:           true,
 				Validators: []validator.String{
 					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("config")),
 				},
 			},
+			"value_wo": schema.StringAttribute{
+				Optional:            true,
+				WriteOnly:           true,
+				Sensitive:           true,
+				MarkdownDescription: "The value for a static secret, never stored in the plan or state. Sent when the secret is created and whenever `value_wo_version` or `rotation_triggers` changes. Requires Terraform 1.11 or later.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRelative().AtParent().AtName("value"),
+						path.MatchRelative().AtParent().AtName("config"),
+					),
+				},
+			},
+			"value_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Version of `value_wo`. Change it, e.g. increment it, to send a new `value_wo` to Bland.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("value_wo")),
+				},
+			},
+			"rotation_triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary keepers that rotate the secret when any of them changes: the value of a static secret, including `value_wo`, or the refresh `config` of a refreshing secret is sent to Bland again.",
+			},
+			"last_refreshed_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp of the last time Terraform sent the value or refresh configuration of the secret, i.e. when it was created or rotated.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"config": schema.SingleNestedAttribute{
-				MarkdownDescription: "Configuration for refreshable secret.",
+				MarkdownDescription: "Configuration for refreshable secret. Changes made outside of Terraform are detected on refresh.",
 				Optional:            true,
 				Validators: []validator.Object{
 					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("value")),
@@ -144,13 +181,14 @@ func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					"body": schema.StringAttribute{
 						MarkdownDescription: "JSON body for the refresh request.",
 						Optional:            true,
+						Sensitive:           true,
 					},
 					"method": schema.StringAttribute{
 						MarkdownDescription: "HTTP method for the refresh request.",
 						Required:            true,
 					},
 					"refresh_interval": schema.Int32Attribute{
-						MarkdownDescription: "Refresh interval for the refresh request.",
+						MarkdownDescription: "Interval in seconds at which Bland sends the refresh request and updates the secret value.",
 						Optional:            true,
 						Computed:            true,
 						Default:             int32default.StaticInt32(60),
@@ -167,6 +205,7 @@ func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						ElementType:         types.StringType,
 						MarkdownDescription: "Headers for the refresh request.",
 						Optional:            true,
+						Sensitive:           true,
 					},
 				},
 			},
@@ -174,6 +213,25 @@ func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *SecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ctx, exitContext := utils.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state SecretModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if secretRotates(plan, state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("last_refreshed_at"), types.StringUnknown())...)
+	}
+}
+
 func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan SecretModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -186,16 +244,27 @@ func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 	model := createSecretDto{
-		Name:  dto.Name,
-		Value: dto.Value,
+		Name:   dto.Name,
+		Value:  dto.Value,
+		Config: dto.Config,
+	}
+	valueWO, diags := r.writeOnlyValue(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if valueWO != nil {
+		model.Value = valueWO
 	}
 	created, err := r.SecretClient.CreateSecret(ctx, model)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating secret", err.Error())
 		return
 	}
-	createdModel := ConvertFromSecretDto(*created)
-	resp.State.Set(ctx, createdModel)
+	// Bland only returns the id of the new secret, the rest of the state comes from the plan and is checked on read.
+	plan.ID = types.StringValue(created.ID)
+	plan.LastRefreshedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -206,17 +275,31 @@ func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 	read, err := r.SecretClient.ReadSecret(ctx, state.ID.ValueString())
 	if err != nil {
+		if errors.Is(err, api.ErrObjectNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Error reading secret", err.Error())
 		return
 	}
 	model := ConvertFromSecretDto(*read)
+	// Bland never returns the value of a secret.
 	model.Value = state.Value
-	resp.State.Set(ctx, model)
+	model.ValueWOVersion = state.ValueWOVersion
+	model.RotationTriggers = state.RotationTriggers
+	model.LastRefreshedAt = state.LastRefreshedAt
+	if model.Static.IsNull() {
+		model.Static = state.Static
+	}
+	model.Config = normalizeSecretConfig(model.Config, state.Config)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
 
 func (r *SecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan SecretModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	var state SecretModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -225,6 +308,16 @@ func (r *SecretResource) Update(ctx context.Context, req resource.UpdateRequest,
 		resp.Diagnostics.AddError("Error parsing secret", err.Error())
 		return
 	}
+	if !plan.ValueWOVersion.Equal(state.ValueWOVersion) || !plan.RotationTriggers.Equal(state.RotationTriggers) {
+		valueWO, diags := r.writeOnlyValue(ctx, req.Config)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if valueWO != nil {
+			dto.Value = valueWO
+		}
+	}
 	if plan.ID.ValueString() == "" {
 		resp.Diagnostics.AddError("Error parsing secret ID", "failed to parse Secret ID")
 		return
@@ -234,13 +327,15 @@ func (r *SecretResource) Update(ctx context.Context, req resource.UpdateRequest,
 		Config: dto.Config,
 		Value:  dto.Value,
 	}
-	updated, err := r.SecretClient.UpdateSecret(ctx, plan.ID.ValueString(), updateDto)
+	_, err = r.SecretClient.UpdateSecret(ctx, plan.ID.ValueString(), updateDto)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating secret", err.Error())
 		return
 	}
-	model := ConvertFromSecretDto(*updated)
-	resp.State.Set(ctx, model)
+	if plan.LastRefreshedAt.IsUnknown() {
+		plan.LastRefreshedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -256,3 +351,18 @@ func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 	resp.State.RemoveResource(ctx)
 }
+
+// secretRotates reports whether applying the plan sends a new value or refresh configuration to Bland.
+func secretRotates(plan, state SecretModel) bool {
+	return !plan.RotationTriggers.Equal(state.RotationTriggers) ||
+		!plan.Value.Equal(state.Value) ||
+		!plan.ValueWOVersion.Equal(state.ValueWOVersion) ||
+		!secretConfigEqual(plan.Config, state.Config)
+}
+
+// writeOnlyValue returns value_wo from the configuration, the only place write-only attributes can be read from.
+func (r *SecretResource) writeOnlyValue(ctx context.Context, config tfsdk.Config) (*string, diag.Diagnostics) {
+	var valueWO types.String
+	diags := config.GetAttribute(ctx, path.Root("value_wo"), &valueWO)
+	return valueWO.ValueStringPointer(), diags
+}
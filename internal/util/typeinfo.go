@@ -11,6 +11,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -198,7 +200,14 @@ type AllowedRequestTypes interface {
 		datasource.SchemaRequest |
 		datasource.ConfigureRequest |
 		datasource.MetadataRequest |
-		datasource.ValidateConfigRequest
+		datasource.ValidateConfigRequest |
+		function.MetadataRequest |
+		function.DefinitionRequest |
+		function.RunRequest |
+		ephemeral.MetadataRequest |
+		ephemeral.SchemaRequest |
+		ephemeral.ConfigureRequest |
+		ephemeral.OpenRequest
 }
 
 // AllowedProviderRequestTypes is an interface that defines the allowed request types for the EnterProviderContext function.